@@ -17,7 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -26,9 +28,128 @@ const (
 	// ConditionTypeDisabled is the condition type used when NHC will get disabled
 	ConditionTypeDisabled = "Disabled"
 
+	// RemediationOwnerNHC makes the NHC itself own created remediation CRs, the default
+	RemediationOwnerNHC = "NHC"
+
+	// RemediationOwnerMachine makes the Machine backing the unhealthy node own created remediation CRs
+	RemediationOwnerMachine = "Machine"
+
+	// RemediationOwnerMachineSet makes the MachineSet owning the node's Machine own created remediation CRs
+	RemediationOwnerMachineSet = "MachineSet"
+
+	// OnTimeoutPolicyKeepAndAlert keeps a timed out remediation CR in place and flags it, the default
+	OnTimeoutPolicyKeepAndAlert = "KeepAndAlert"
+
+	// OnTimeoutPolicyRecreate deletes and recreates a timed out remediation CR to retry the remediation
+	OnTimeoutPolicyRecreate = "Recreate"
+
+	// OnTimeoutPolicyDelete deletes a timed out remediation CR and marks the node as exhausted
+	OnTimeoutPolicyDelete = "Delete"
+
+	// DrainPolicyNone skips the pre-remediation drain, the default
+	DrainPolicyNone = "None"
+
+	// DrainPolicyFull evicts all evictable pods from the unhealthy node before its remediation CR is created
+	DrainPolicyFull = "Full"
+
+	// DrainPolicyLowPriorityOnly evicts only the pods whose priority is below the configured
+	// threshold, preserving important capacity while still signaling the remediator
+	DrainPolicyLowPriorityOnly = "LowPriorityOnly"
+
+	// ConditionProfileResolutionFirstMatch selects the first condition profile in list
+	// order whose selector matches the node, the default
+	ConditionProfileResolutionFirstMatch = "FirstMatch"
+
+	// ConditionProfileResolutionMostSpecific selects the matching condition profile with
+	// the most match labels and expressions, falling back to list order on ties
+	ConditionProfileResolutionMostSpecific = "MostSpecific"
+
+	// PhaseDisabled means the NHC doesn't act, e.g. because of conflicting MachineHealthChecks
+	PhaseDisabled = "Disabled"
+
+	// PhaseDryRun means the NHC only reports the remediations it would perform
+	PhaseDryRun = "DryRun"
+
+	// PhasePaused means pause requests are in place, deferring new remediations
+	PhasePaused = "Paused"
+
+	// PhaseRemediating means at least one remediation is in flight
+	PhaseRemediating = "Remediating"
+
+	// PhaseEnabled means the NHC observes its nodes and no remediation is in flight
+	PhaseEnabled = "Enabled"
+
+	// ConditionReasonDisabledTargetNodesRemediated is the condition reason for type Disabled once
+	// all nodes of a target node campaign have been remediated at least once
+	ConditionReasonDisabledTargetNodesRemediated = "TargetNodesRemediated"
+
+	// ConditionReasonDisabledClusterTooSmall is the condition reason for type Disabled while
+	// the number of observed nodes is below the configured minimum cluster size
+	ConditionReasonDisabledClusterTooSmall = "ClusterTooSmall"
+
 	// ConditionReasonDisabledMHC is the condition reason for type Disabled in case NHC is disabled because
 	// of conflicts with MHC
 	ConditionReasonDisabledMHC = "MachineHealthCheckDetected"
+
+	// ConditionTypeEmergencyStopped is the condition type used when all remediation is halted
+	// by the cluster-wide emergency stop
+	ConditionTypeEmergencyStopped = "EmergencyStopped"
+
+	// ConditionReasonEmergencyStopConfigMap is the condition reason for type EmergencyStopped
+	// in case the emergency stop ConfigMap was found
+	ConditionReasonEmergencyStopConfigMap = "EmergencyStopConfigMapFound"
+
+	// ConditionTypeAllNodesHealthy is the condition type used when all nodes selected by the NHC
+	// are healthy and no remediation is in flight
+	ConditionTypeAllNodesHealthy = "AllNodesHealthy"
+
+	// ConditionReasonAllNodesHealthy is the condition reason for type AllNodesHealthy when it is true
+	ConditionReasonAllNodesHealthy = "NodesHealthy"
+
+	// ConditionReasonUnhealthyNodesFound is the condition reason for type AllNodesHealthy when unhealthy
+	// nodes were found or remediations are still in flight
+	ConditionReasonUnhealthyNodesFound = "UnhealthyNodesFound"
+
+	// ConditionReasonAllNodesUnhealthy is the condition reason for type AllNodesHealthy when every
+	// selected node is unhealthy and remediation proceeds despite the violated MinHealthy gate
+	ConditionReasonAllNodesUnhealthy = "AllNodesUnhealthy"
+
+	// ConditionTypeRemediationFailed is the condition type used when a remediator reported
+	// a terminal failure for an in-flight remediation
+	ConditionTypeRemediationFailed = "RemediationFailed"
+
+	// ConditionReasonTerminalFailure is the condition reason for type RemediationFailed when
+	// a remediation CR carries one of the configured terminal failure conditions
+	ConditionReasonTerminalFailure = "TerminalFailureReported"
+
+	// ConditionReasonNoTerminalFailure is the condition reason for type RemediationFailed when
+	// no terminal failure is reported anymore
+	ConditionReasonNoTerminalFailure = "NoTerminalFailureReported"
+
+	// ConditionTypeQuorumBlocked is the condition type used when the NHC selects only
+	// control-plane nodes and all of them are unhealthy, so no node can be remediated
+	// without breaking quorum
+	ConditionTypeQuorumBlocked = "QuorumBlocked"
+
+	// ConditionReasonAllControlPlaneUnhealthy is the condition reason for type QuorumBlocked
+	// while all selected control-plane nodes are unhealthy
+	ConditionReasonAllControlPlaneUnhealthy = "AllControlPlaneNodesUnhealthy"
+
+	// ConditionReasonQuorumNotBlocked is the condition reason for type QuorumBlocked once
+	// the deadlock is resolved
+	ConditionReasonQuorumNotBlocked = "NotBlocked"
+
+	// ConditionTypeCanaryFailed is the condition type used when the canary remediation
+	// of a remediation wave failed or timed out, halting the remaining remediations
+	ConditionTypeCanaryFailed = "CanaryFailed"
+
+	// ConditionReasonCanaryRemediationFailed is the condition reason for type CanaryFailed
+	// while the canary node's remediation failed or timed out without the node recovering
+	ConditionReasonCanaryRemediationFailed = "CanaryRemediationFailed"
+
+	// ConditionReasonCanaryNotFailed is the condition reason for type CanaryFailed once
+	// no failed canary remediation holds back remediation anymore
+	ConditionReasonCanaryNotFailed = "NotFailed"
 )
 
 // NodeHealthCheckSpec defines the desired state of NodeHealthCheck
@@ -42,12 +163,72 @@ type NodeHealthCheckSpec struct {
 	// UnhealthyConditions contains a list of the conditions that determine
 	// whether a node is considered unhealthy.  The conditions are combined in a
 	// logical OR, i.e. if any of the conditions is met, the node is unhealthy.
+	// When not set, the mutating webhook defaults it to Ready=False and
+	// Ready=Unknown, each lasting 300s.
 	//
 	// +optional
-	// +kubebuilder:default:={{type:Ready,status:False,duration:"300s"},{type:Ready,status:Unknown,duration:"300s"}}
 	// +operator-sdk:csv:customresourcedefinitions:type=spec
 	UnhealthyConditions []UnhealthyCondition `json:"unhealthyConditions,omitempty"`
 
+	// MinUnhealthyConditionCount is the number of distinct UnhealthyConditions
+	// which have to match, each past its duration, before the node is considered
+	// unhealthy. By default a single matching condition suffices; requiring e.g.
+	// at least 2 distinct problems reduces false positives, as a middle ground
+	// between matching any and matching all conditions.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MinUnhealthyConditionCount int `json:"minUnhealthyConditionCount,omitempty"`
+
+	// UnhealthyAnnotations treat a node as unhealthy when it carries a matching
+	// annotation for the given duration, bridging NHC with external detectors
+	// (hardware monitors, BMC pollers) which can't set node conditions. The
+	// annotations are combined in a logical OR with the UnhealthyConditions.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	UnhealthyAnnotations []UnhealthyAnnotation `json:"unhealthyAnnotations,omitempty"`
+
+	// UnhealthyTaints treat a node as unhealthy when it carries a matching
+	// taint for the given duration. In some failure modes taints like
+	// "node.kubernetes.io/unreachable" are added before the NodeReady condition
+	// flips, so taint based detection catches such nodes earlier than the
+	// condition based one. The taints are combined in a logical OR with the
+	// UnhealthyConditions.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	UnhealthyTaints []UnhealthyTaint `json:"unhealthyTaints,omitempty"`
+
+	// ConditionProfiles overrides the UnhealthyConditions per node class: for
+	// each node, the profile whose selector matches the node - resolved per
+	// ConditionProfileResolution when several match - supplies the unhealthy
+	// conditions, falling back to UnhealthyConditions when none matches. This
+	// lets differently behaving node classes, e.g. edge nodes on flaky links
+	// versus datacenter nodes, share one NHC with different timing.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ConditionProfiles []ConditionProfile `json:"conditionProfiles,omitempty"`
+
+	// ConditionProfileResolution picks the profile to use when several
+	// ConditionProfiles match the same node. "FirstMatch" uses the first
+	// matching profile in list order. "MostSpecific" uses the matching profile
+	// whose selector has the most match labels and expressions, so a narrow
+	// edge-node profile wins over a broad catch-all regardless of list order;
+	// ties fall back to list order. Defaults to "FirstMatch".
+	// +kubebuilder:validation:Enum=FirstMatch;MostSpecific
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ConditionProfileResolution string `json:"conditionProfileResolution,omitempty"`
+
+	// ConditionFlapThresholds treats a node as unhealthy when one of the listed
+	// condition types changes status more often within the window than allowed,
+	// even when no status ever lasts long enough to match the UnhealthyConditions.
+	// This catches degrading nodes whose MemoryPressure or DiskPressure toggles
+	// rapidly while Ready stays true. The flap thresholds are combined in a
+	// logical OR with the UnhealthyConditions.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ConditionFlapThresholds []ConditionFlapThreshold `json:"conditionFlapThresholds,omitempty"`
+
 	// Remediation is allowed if at least "MinHealthy" nodes selected by "selector" are healthy.
 	// Expects either a positive integer value or a percentage value.
 	// Percentage values must be positive whole numbers and are capped at 100%.
@@ -58,13 +239,70 @@ type NodeHealthCheckSpec struct {
 	//+operator-sdk:csv:customresourcedefinitions:type=spec
 	MinHealthy *intstr.IntOrString `json:"minHealthy,omitempty"`
 
+	// ExpectedNodes is the absolute number of nodes the selector is expected to
+	// match when the cluster is healthy. When set, remediation is allowed only if
+	// at least ExpectedNodes minus ExpectedNodesTolerance nodes are actually
+	// healthy, replacing the MinHealthy percentage-of-observed calculation. This
+	// protects against nodes vanishing from the API entirely, which makes
+	// percentages of the observed count misleading.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ExpectedNodes *int `json:"expectedNodes,omitempty"`
+
+	// ExpectedNodesTolerance is the number of nodes ExpectedNodes may fall short
+	// by before remediation is blocked. Only used when ExpectedNodes is set.
+	// Defaults to zero, i.e. all expected nodes must be healthy.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ExpectedNodesTolerance *int `json:"expectedNodesTolerance,omitempty"`
+
+	// AllowRemediationWhenAllUnhealthy bypasses the MinHealthy gate when every
+	// single selected node is unhealthy, on the theory that doing nothing
+	// guarantees a continued outage while remediating at least may recover it.
+	// Concurrency caps still apply. Use with care, this remediates in exactly
+	// the situation MinHealthy normally treats as too risky to act in.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	AllowRemediationWhenAllUnhealthy bool `json:"allowRemediationWhenAllUnhealthy,omitempty"`
+
+	// NonDisruptiveRemediation declares this NHC's remediation as not reducing
+	// availability, e.g. a job restarting a stuck service without rebooting
+	// the node. Non-disruptive remediations proceed even when the MinHealthy
+	// gate would block them; the concurrency cap still applies. With
+	// escalating remediations mark the individual steps as non-disruptive
+	// instead.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	NonDisruptiveRemediation bool `json:"nonDisruptiveRemediation,omitempty"`
+
 	// RemediationTemplate is a reference to a remediation template
 	// provided by an infrastructure provider.
 	//
 	// If a node needs remediation the controller will create an object from this template
 	// and then it should be picked up by a remediation provider.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RemediationTemplate *corev1.ObjectReference `json:"remediationTemplate,omitempty"`
+
+	// RemediationJobTemplate is an alternative to RemediationTemplate for clusters
+	// without an external remediation provider: if a node needs remediation the
+	// controller creates a Job from this template, with the node name injected into
+	// the Job's pod as the NODE_NAME environment variable, and considers the node
+	// remediated when the Job completes. The Job follows the same lifecycle as
+	// remediation CRs and is deleted when the node turns healthy again.
+	// Mutually exclusive with RemediationTemplate.
+	// +optional
 	//+operator-sdk:csv:customresourcedefinitions:type=spec
-	RemediationTemplate *corev1.ObjectReference `json:"remediationTemplate"`
+	RemediationJobTemplate *batchv1.JobTemplateSpec `json:"remediationJobTemplate,omitempty"`
+
+	// ScheduledMinHealthy contains daily time windows during which the base MinHealthy
+	// is overridden, e.g. for conservative remediation during business hours and
+	// aggressive remediation overnight. The first matching window wins.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ScheduledMinHealthy []ScheduledMinHealthy `json:"scheduledMinHealthy,omitempty"`
 
 	// PauseRequests will prevent any new remdiation to start, while in-flight remediations
 	// keep running. Each entry is free form, and ideally represents the requested party reason
@@ -73,6 +311,640 @@ type NodeHealthCheckSpec struct {
 	// +optional
 	//+operator-sdk:csv:customresourcedefinitions:type=spec
 	PauseRequests []string `json:"pauseRequests,omitempty"`
+
+	// DryRun reports the remediations this NHC would perform without creating
+	// any remediation CR. The computed CRs are surfaced as synthetic entries in
+	// the unhealthy node status instead, for validating selectors and the
+	// MinHealthy math before arming remediation.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// NodeResourceRef references an alternative, node-like resource kind whose
+	// health should be checked instead of core Nodes, e.g. namespaced logical
+	// nodes in virtual cluster setups. The referenced kind is expected to expose
+	// node-style conditions under .status.conditions.
+	// When not set core v1 Nodes are used.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	NodeResourceRef *NodeResourceRef `json:"nodeResourceRef,omitempty"`
+
+	// PeerConfirmation configures an optional peer endpoint which is asked to
+	// confirm that a node is unhealthy from another vantage point before a
+	// remediation is started. An unreachable peer does not block remediation.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	PeerConfirmation *PeerConfirmation `json:"peerConfirmation,omitempty"`
+
+	// PrerequisiteHealthGate suppresses all remediation of this NHC while the
+	// broader cluster is not confirmed healthy, e.g. during a monitoring
+	// outage or a major incident. The gate is met when the referenced
+	// resource's condition is True and the endpoint, when set, reports
+	// healthy. While the gate is unmet remediation is skipped with a clear
+	// reason and retried later.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	PrerequisiteHealthGate *PrerequisiteHealthGate `json:"prerequisiteHealthGate,omitempty"`
+
+	// EscalatingRemediations contains a list of ordered remediation templates with timeouts.
+	// The remediation templates will be used one after another, until the node gets healthy
+	// or the last remediation timed out. Takes precedence over RemediationTemplate when set.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	EscalatingRemediations []EscalatingRemediation `json:"escalatingRemediations,omitempty"`
+
+	// RemediationByNodeInfo maps nodes to remediation templates by their operating
+	// system and/or architecture, e.g. for remediating Windows nodes differently
+	// than Linux nodes under one selector. The first matching entry wins, and the
+	// default RemediationTemplate is used when no entry matches.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RemediationByNodeInfo []RemediationByNodeInfo `json:"remediationByNodeInfo,omitempty"`
+
+	// RemediationOwnerReference tunes the ownerReference which is put on created
+	// remediation CRs, for adjusting garbage-collection interactions.
+	// When not set, Controller is false and BlockOwnerDeletion is left unset.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RemediationOwnerReference *RemediationOwnerReference `json:"remediationOwnerReference,omitempty"`
+
+	// ProtectInFlightRemediations adds a finalizer to created remediation CRs,
+	// removed only once the remediation completed, or released by the NHC's own
+	// finalizer cleanup when the NHC gets deleted. This prevents owner-reference
+	// garbage collection from aborting an active remediation mid-flight, which
+	// could leave a node cordoned forever.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ProtectInFlightRemediations bool `json:"protectInFlightRemediations,omitempty"`
+
+	// RemediationOwner controls which object owns the created remediation CRs:
+	// the NHC itself (the default), the Machine backing the unhealthy node, or
+	// the MachineSet owning that Machine, for remediators acting on the machine
+	// level. When the node has no backing Machine, e.g. on non-OpenShift
+	// clusters, the controller falls back to the NHC as owner.
+	// +optional
+	// +kubebuilder:validation:Enum=NHC;Machine;MachineSet
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RemediationOwner string `json:"remediationOwner,omitempty"`
+
+	// PostRemediationVerifyPeriod requires a remediated node which turned
+	// healthy again to stay healthy for this period before the remediation is
+	// declared successful and cleaned up. A node turning unhealthy again within
+	// the period keeps its remediation in place, so it escalates or
+	// re-remediates instead of flapping between healthy and unhealthy.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	PostRemediationVerifyPeriod *metav1.Duration `json:"postRemediationVerifyPeriod,omitempty"`
+
+	// UncordonAfterRemediation makes a remediated node schedulable again when it
+	// returned to health but stayed cordoned because the remediator didn't
+	// un-cordon it. Only a node whose remediation object this NHC just cleaned
+	// up is un-cordoned, right at the remediation's completion, so nodes
+	// cordoned for unrelated reasons are left alone.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	UncordonAfterRemediation bool `json:"uncordonAfterRemediation,omitempty"`
+
+	// RemediationResultURL is the URL a structured result is POSTed to when a
+	// remediation completes: either the node recovered and its remediation CRs
+	// were removed, or a timed out remediation was marked exhausted. The payload
+	// includes outcome, duration and the number of escalation steps used, for
+	// external dashboards tracking end-to-end remediation outcomes. Delivery is
+	// non-blocking and retried a few times, i.e. best effort.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RemediationResultURL string `json:"remediationResultURL,omitempty"`
+
+	// MachineAPIGroup is the API group of the Machine objects backing the
+	// nodes, for the machine based remediation owners. Defaults to the
+	// OpenShift machine API group; upstream Cluster API users set
+	// "cluster.x-k8s.io". The node to machine mapping follows the respective
+	// group's annotation conventions.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MachineAPIGroup string `json:"machineAPIGroup,omitempty"`
+
+	// MachineKind is the kind of the Machine objects backing the nodes, for
+	// the machine based remediation owners. Defaults to "Machine".
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MachineKind string `json:"machineKind,omitempty"`
+
+	// HTTPProbe configures an additional HTTP health probe performed against each
+	// selected node, for catching node problems which aren't reflected in node
+	// conditions, e.g. a stuck local service.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	HTTPProbe *HTTPProbe `json:"httpProbe,omitempty"`
+
+	// MaxConcurrentRemediations caps how many remediations this NHC may have in
+	// flight at the same time. Unlike MinHealthy, which guards the healthy floor,
+	// this caps concurrent remediation activity. Expects either a positive integer
+	// or a percentage of the selected nodes. Nodes beyond the cap are deferred to
+	// a later reconcile. When not set, concurrency is only limited by MinHealthy.
+	// +kubebuilder:validation:Pattern="^((100|[0-9]{1,2})%|[0-9]+)$"
+	// +kubebuilder:validation:Type=string
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MaxConcurrentRemediations *intstr.IntOrString `json:"maxConcurrentRemediations,omitempty"`
+
+	// CanaryRemediation remediates one node first when several nodes are
+	// eligible at once: the node unhealthy the longest becomes the canary, and
+	// the remaining nodes are only remediated once the canary node returned to
+	// health. A canary remediation which fails terminally or times out halts
+	// the remaining remediations, surfaced via the CanaryFailed condition. This
+	// de-risks mass remediation when the remediator's behavior is uncertain.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	CanaryRemediation bool `json:"canaryRemediation,omitempty"`
+
+	// TerminalFailureConditionTypes lists condition types which, when reported with
+	// status True on a remediation CR by the remediator, mark the remediation as
+	// terminally failed. Escalating remediations then move on to the next template
+	// immediately instead of waiting for the timeout. When empty, terminal failure
+	// detection is disabled.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	TerminalFailureConditionTypes []string `json:"terminalFailureConditionTypes,omitempty"`
+
+	// RemediationConditionMapping maps the condition types the controller
+	// inspects on remediation CRs to the names the remediator actually reports,
+	// for remediators which don't follow the conventional medik8s condition
+	// names. When not set, the conventional names "Succeeded" and "Processing"
+	// are used.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RemediationConditionMapping *RemediationConditionMapping `json:"remediationConditionMapping,omitempty"`
+
+	// RequireApproval inserts a human gate between detection and remediation: the
+	// controller marks the remediation as pending approval and only creates the
+	// remediation CR once an approver set the "remediation.medik8s.io/approved-by"
+	// annotation on the NHC or the node.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RequireApproval bool `json:"requireApproval,omitempty"`
+
+	// AutoApproveTimeout lets a pending approval proceed automatically when no
+	// approver reacted within the timeout. Only relevant with RequireApproval.
+	// When not set, remediation waits for approval indefinitely.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	AutoApproveTimeout *metav1.Duration `json:"autoApproveTimeout,omitempty"`
+
+	// DisableStuckRemediationAlert stops the controller from writing the
+	// old-remediation-cr-flag annotation onto long-running remediation CRs, for
+	// remediators which reconcile on any CR change. The stuck remediation metric
+	// still fires. Defaults to writing the annotation.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	DisableStuckRemediationAlert bool `json:"disableStuckRemediationAlert,omitempty"`
+
+	// OnTimeoutPolicy controls what happens when the single, non-escalating
+	// remediation CR times out while the node is still unhealthy. "KeepAndAlert"
+	// keeps the CR in place and flags it, the default. "Recreate" deletes and
+	// recreates the CR to retry the remediation. "Delete" removes the CR and
+	// marks the node as exhausted, without retrying. Only used without
+	// escalating remediations, which handle their timeouts by escalating.
+	// +kubebuilder:validation:Enum=KeepAndAlert;Recreate;Delete
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	OnTimeoutPolicy string `json:"onTimeoutPolicy,omitempty"`
+
+	// RemediationCRAlertTimeout is after how long an in-flight remediation CR
+	// counts as old: it is flagged via annotation and metric, and - without
+	// escalating remediations - the OnTimeoutPolicy applies. Clusters whose
+	// remediation legitimately takes longer, e.g. bare metal reprovisioning,
+	// should raise it. Must be at least one minute. Defaults to 48h.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RemediationCRAlertTimeout *metav1.Duration `json:"remediationCRAlertTimeout,omitempty"`
+
+	// PreRemediationDrainPolicy drains the unhealthy node's pods right before its
+	// remediation CR is created. "Full" evicts all evictable pods. "LowPriorityOnly"
+	// evicts only the pods whose priority is below PreRemediationDrainPriorityThreshold,
+	// preserving important capacity while still signaling the remediator. DaemonSet-managed
+	// and mirror pods are never evicted. The drain is best effort, eviction failures
+	// don't block the remediation. Defaults to "None", no drain.
+	// +kubebuilder:validation:Enum=None;Full;LowPriorityOnly
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	PreRemediationDrainPolicy string `json:"preRemediationDrainPolicy,omitempty"`
+
+	// PreRemediationDrainPriorityThreshold is the pod priority below which the
+	// "LowPriorityOnly" drain policy evicts pods. Pods without a priority are
+	// treated as priority 0. Only relevant with that policy. Defaults to 0,
+	// evicting only negative-priority pods.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	PreRemediationDrainPriorityThreshold *int32 `json:"preRemediationDrainPriorityThreshold,omitempty"`
+
+	// HistoryLimit caps how many completed entries Status.RemediationHistory
+	// keeps, so a busy NHC doesn't grow its status without bound. Entries of
+	// in-flight remediations don't count against the limit and are never
+	// pruned. Defaults to 10.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	HistoryLimit *int `json:"historyLimit,omitempty"`
+
+	// HistoryTTL drops completed Status.RemediationHistory entries which
+	// completed longer than this ago, regardless of the HistoryLimit. When not
+	// set, only the HistoryLimit bounds the history.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	HistoryTTL *metav1.Duration `json:"historyTTL,omitempty"`
+
+	// MinDelayBetweenRemediations is the minimum interval between the creation of
+	// two remediation CRs by this NHC. Nodes whose remediation would violate the
+	// spacing are deferred and retried, smoothing the load on provisioning
+	// infrastructure during correlated failures.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MinDelayBetweenRemediations *metav1.Duration `json:"minDelayBetweenRemediations,omitempty"`
+
+	// DeferredRequeueInterval is how soon unhealthy nodes whose remediation was
+	// deferred for a retryable reason - the MinHealthy gate, anti-affinity, the
+	// concurrency cap, a cluster upgrade - are re-evaluated. A pool recovering
+	// quickly benefits from a tighter interval, so recovery-driven remediation
+	// kicks in promptly. Must be at least 5 seconds. Defaults to 1 minute.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	DeferredRequeueInterval *metav1.Duration `json:"deferredRequeueInterval,omitempty"`
+
+	// ResourceAvailabilityConditions treat a node as unhealthy when the allocatable
+	// amount of a named resource stays below a minimum for a duration, e.g. a Ready
+	// GPU node whose device plugin reports zero allocatable GPUs. The conditions are
+	// combined in a logical OR with the UnhealthyConditions.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ResourceAvailabilityConditions []ResourceAvailabilityCondition `json:"resourceAvailabilityConditions,omitempty"`
+
+	// PodDisruptionThreshold treats a node as unhealthy when more pods than
+	// allowed were OOM-killed on or evicted from it within a window, e.g. a node
+	// under memory pressure which stays Ready while OOM-killing its workloads.
+	// The signal is combined in a logical OR with the UnhealthyConditions.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	PodDisruptionThreshold *PodDisruptionThreshold `json:"podDisruptionThreshold,omitempty"`
+
+	// MinKubeletVersion treats a node as unhealthy when the kubelet version it
+	// reports stays below this semantic version, e.g. "v1.25.0", for the
+	// MinKubeletVersionDuration. This is a proactive remediation of nodes
+	// stuck on an old kubelet after a failed upgrade, not a failure-driven
+	// one; such nodes are reported with a distinct reason in the status'
+	// unhealthy nodes.
+	// +kubebuilder:validation:Pattern="^v?[0-9]+(\\.[0-9]+){0,2}$"
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MinKubeletVersion string `json:"minKubeletVersion,omitempty"`
+
+	// MinKubeletVersionDuration is how long a node's kubelet version must stay
+	// below MinKubeletVersion before the node becomes a remediation candidate,
+	// guarding against remediating nodes which are just mid-upgrade. When not
+	// set, an outdated kubelet makes the node a candidate immediately.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MinKubeletVersionDuration *metav1.Duration `json:"minKubeletVersionDuration,omitempty"`
+
+	// RemediateOnKubeletCertExpiry treats a node as unhealthy when one of its
+	// conditions carries a known kubelet certificate expiry pattern, e.g. an
+	// "x509: certificate has expired" message behind a Ready=Unknown condition.
+	// An expired kubelet client certificate silently stops status updates, so
+	// without this the node only fails through the generic Ready condition
+	// without a hint at the distinctly actionable cause. Such nodes are
+	// reported with a dedicated reason in the status' unhealthy nodes.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	RemediateOnKubeletCertExpiry bool `json:"remediateOnKubeletCertExpiry,omitempty"`
+
+	// WorkloadNamespaces limits remediation to unhealthy nodes which also host
+	// failing pods from one of the listed namespaces, scoping remediation to
+	// business-impacting failures. When empty all unhealthy nodes are remediated.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	WorkloadNamespaces []string `json:"workloadNamespaces,omitempty"`
+
+	// TargetNodes restricts remediation to exactly the listed nodes, for one-time
+	// remediation campaigns against an enumerated set of nodes, e.g. a batch
+	// flagged by an audit. Health checks still apply, i.e. only listed nodes which
+	// are also unhealthy get remediated. Once all listed nodes have been
+	// remediated at least once the NHC disables itself, until the list changes.
+	// When empty all unhealthy nodes selected by the selector are remediated.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	TargetNodes []string `json:"targetNodes,omitempty"`
+
+	// ExcludeControlPlane removes control-plane nodes from the candidate set
+	// entirely, regardless of the selector, for clusters which manage
+	// control-plane health separately. The excluded nodes are reported in the
+	// status as intentionally untouched.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ExcludeControlPlane bool `json:"excludeControlPlane,omitempty"`
+
+	// ProtectOperatorNode skips remediation of the node currently hosting the
+	// active operator instance, so the operator doesn't contribute to a reboot
+	// of its own host mid-decision. With leader election only the leader
+	// reconciles, i.e. the protected node is the leader's host; once leadership
+	// moves to a pod on another node, the formerly protected node is remediated
+	// like any other. The host node is discovered via the downward API.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	ProtectOperatorNode bool `json:"protectOperatorNode,omitempty"`
+
+	// MinClusterSize is a floor of observed nodes below which the NHC refuses to
+	// operate at all, protecting tiny clusters where remediating a node could
+	// take the whole cluster down. Unlike MinHealthy, which compares healthy
+	// nodes against a threshold, this compares the total number of observed
+	// nodes. Zero disables the floor.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MinClusterSize int `json:"minClusterSize,omitempty"`
+
+	// InheritFrom references another NodeHealthCheck whose settings are used as
+	// defaults for fields this NHC leaves unset: selector, unhealthy conditions,
+	// minHealthy and the remediation template. Inheritance is resolved at
+	// reconcile time, so changes of the parent propagate without re-writing the
+	// children. Chains are allowed, cycles are rejected.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	InheritFrom *corev1.LocalObjectReference `json:"inheritFrom,omitempty"`
+
+	// AntiAffinityLabel names a node label marking operator-defined failure
+	// domains, e.g. "topology.example.com/rack". At most one node per distinct
+	// label value is remediated concurrently, so nodes sharing e.g. a top-of-rack
+	// switch or a PDU aren't taken down together, even when MinHealthy would
+	// permit it. Nodes without the label are not restricted.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	AntiAffinityLabel string `json:"antiAffinityLabel,omitempty"`
+
+	// NetworkIsolationSafeguard halts remediation when the observed unhealthiness
+	// looks like a network partition affecting the operator itself rather than
+	// genuine node failures, to avoid self-inflicted mass remediation.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	NetworkIsolationSafeguard *NetworkIsolationSafeguard `json:"networkIsolationSafeguard,omitempty"`
+
+	// MirrorStatusToNodes lets the controller mirror per-node remediation state onto
+	// the nodes themselves as labels and annotations, for node-centric dashboards.
+	// The labels are cleared again when the node is healthy.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	MirrorStatusToNodes bool `json:"mirrorStatusToNodes,omitempty"`
+
+	// SummaryEventsOnly suppresses the granular per-node events and emits a single
+	// aggregated summary event per reconcile instead, when the summary changed.
+	// This dramatically reduces the event volume for large, frequently changing
+	// node pools while preserving a readable audit trail.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	SummaryEventsOnly bool `json:"summaryEventsOnly,omitempty"`
+
+	// AllowStartOrderOverride allows pinning the escalation start order of a single node
+	// via the "remediation.medik8s.io/start-order" node annotation, e.g. for nodes known
+	// to need the aggressive remediation right away.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	AllowStartOrderOverride bool `json:"allowStartOrderOverride,omitempty"`
+
+	// AllowTimeoutOverride allows scaling the escalation step timeouts of a single node
+	// via the "remediation.medik8s.io/timeout-multiplier" node annotation, e.g. for
+	// large bare-metal boxes which take much longer to reprovision.
+	// +optional
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	AllowTimeoutOverride bool `json:"allowTimeoutOverride,omitempty"`
+}
+
+// HTTPProbe holds the configuration of a per-node HTTP health probe.
+type HTTPProbe struct {
+	// URLTemplate of the probe. The placeholders "{{.NodeName}}" and "{{.NodeIP}}"
+	// are replaced with the node's name and first internal IP, e.g.
+	// "http://{{.NodeIP}}:9100/healthz".
+	// +kubebuilder:validation:MinLength=1
+	URLTemplate string `json:"urlTemplate"`
+
+	// ExpectedStatus is the HTTP status code expected from a healthy node.
+	// +kubebuilder:default:=200
+	// +optional
+	ExpectedStatus int `json:"expectedStatus,omitempty"`
+
+	// Timeout for the probe request.
+	// +kubebuilder:default:="5s"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Duration for which the probe has to keep failing before the node is
+	// considered unhealthy. Defaults to considering the node unhealthy on the
+	// first failed probe.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// ProbeFailureIsUnhealthy treats failures of the probe itself (DNS, connect)
+	// like an unhealthy response. When false such failures count as healthy.
+	// +optional
+	ProbeFailureIsUnhealthy bool `json:"probeFailureIsUnhealthy,omitempty"`
+}
+
+// NetworkIsolationSafeguard configures when mass unhealthiness is treated as a
+// suspected network partition, in which case remediation is skipped for the
+// cycle instead of rebooting a potentially healthy fleet.
+type NetworkIsolationSafeguard struct {
+	// UnhealthyRatioAbort is the number (or percentage) of selected nodes which,
+	// when they turned unhealthy within CorrelationWindow of each other, makes
+	// the controller suspect a network partition and abort remediation.
+	// +kubebuilder:validation:XIntOrString
+	UnhealthyRatioAbort intstr.IntOrString `json:"unhealthyRatioAbort"`
+
+	// CorrelationWindow is the time window within which the unhealthy transitions
+	// must have happened to count as correlated. Defaults to 1 minute.
+	// +optional
+	CorrelationWindow *metav1.Duration `json:"correlationWindow,omitempty"`
+
+	// APIConnectivityCooldown is how long remediation stays halted after the
+	// operator lost and regained connectivity to the API server, since the nodes'
+	// reported state is likely stale right after a partition. Defaults to 2 minutes.
+	// +optional
+	APIConnectivityCooldown *metav1.Duration `json:"apiConnectivityCooldown,omitempty"`
+}
+
+// ScheduledMinHealthy overrides the base MinHealthy during a daily time window.
+type ScheduledMinHealthy struct {
+	// Start of the daily window, in 24-hour "HH:MM" format, UTC.
+	// +kubebuilder:validation:Pattern="^([01][0-9]|2[0-3]):[0-5][0-9]$"
+	Start string `json:"start"`
+
+	// End of the daily window, in 24-hour "HH:MM" format, UTC.
+	// Windows may wrap around midnight, i.e. End may be before Start.
+	// +kubebuilder:validation:Pattern="^([01][0-9]|2[0-3]):[0-5][0-9]$"
+	End string `json:"end"`
+
+	// MinHealthy to use while the window is active, with the same semantics
+	// as the base MinHealthy.
+	// +kubebuilder:validation:Pattern="^((100|[0-9]{1,2})%|[0-9]+)$"
+	// +kubebuilder:validation:Type=string
+	MinHealthy *intstr.IntOrString `json:"minHealthy"`
+}
+
+// RemediationByNodeInfo maps nodes with a certain operating system and/or
+// architecture to a dedicated remediation template.
+type RemediationByNodeInfo struct {
+	// OperatingSystem to match against node.Status.NodeInfo.OperatingSystem,
+	// e.g. "linux" or "windows". Empty matches any operating system.
+	// +optional
+	OperatingSystem string `json:"operatingSystem,omitempty"`
+
+	// Architecture to match against node.Status.NodeInfo.Architecture,
+	// e.g. "amd64" or "arm64". Empty matches any architecture.
+	// +optional
+	Architecture string `json:"architecture,omitempty"`
+
+	// RemediationTemplate is a reference to the remediation template to use
+	// for matching nodes.
+	RemediationTemplate corev1.ObjectReference `json:"remediationTemplate"`
+}
+
+// RemediationOwnerReference holds the configurable parts of the ownerReference
+// put on created remediation CRs.
+type RemediationOwnerReference struct {
+	// Controller is the value of the ownerReference's controller field.
+	// +optional
+	Controller *bool `json:"controller,omitempty"`
+
+	// BlockOwnerDeletion is the value of the ownerReference's blockOwnerDeletion field,
+	// for setups relying on foreground deletion semantics.
+	// +optional
+	BlockOwnerDeletion *bool `json:"blockOwnerDeletion,omitempty"`
+}
+
+// RemediationConditionMapping names the condition types the controller inspects
+// on remediation CRs, for remediators which don't follow the conventional
+// medik8s condition names.
+type RemediationConditionMapping struct {
+	// SucceededType is the condition type the remediator reports with status
+	// True once the remediation succeeded.
+	// +kubebuilder:validation:MinLength=1
+	SucceededType string `json:"succeededType"`
+
+	// ProcessingType is the condition type the remediator reports with status
+	// True while the remediation is still in progress.
+	// +kubebuilder:validation:MinLength=1
+	ProcessingType string `json:"processingType"`
+
+	// FailedType is the condition type the remediator reports with status True
+	// when the remediation failed terminally, in addition to the
+	// terminalFailureConditionTypes. When empty, only those are considered.
+	// +optional
+	FailedType string `json:"failedType,omitempty"`
+}
+
+// EscalatingRemediation is a remediation template with an order and a timeout,
+// used for escalating through multiple remediators.
+type EscalatingRemediation struct {
+	// RemediationTemplate is a reference to a remediation template
+	// provided by a remediation provider.
+	RemediationTemplate corev1.ObjectReference `json:"remediationTemplate"`
+
+	// Order defines the order for this remediation.
+	// Remediations with a lower order will be used before remediations with a higher order.
+	Order int `json:"order"`
+
+	// Timeout defines how long NHC will wait for the remediation to succeed,
+	// before the next remediation is used.
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:validation:Type=string
+	Timeout metav1.Duration `json:"timeout"`
+
+	// NonDisruptive declares this step's remediation as not reducing
+	// availability, e.g. a job restarting a stuck service without rebooting
+	// the node. The MinHealthy gate is only skipped when every step of the
+	// escalation chain is non-disruptive, since an escalation reaching a
+	// disruptive step must not bypass the gate.
+	// +optional
+	NonDisruptive bool `json:"nonDisruptive,omitempty"`
+}
+
+// PrerequisiteHealthGate describes the cluster health confirmation required
+// before any remediation proceeds, via a resource condition, an HTTP
+// endpoint, or both.
+type PrerequisiteHealthGate struct {
+	// ResourceRef points at a resource whose condition reflects the broader
+	// cluster health, e.g. a monitoring stack's umbrella object.
+	// +optional
+	ResourceRef *corev1.ObjectReference `json:"resourceRef,omitempty"`
+
+	// ConditionType is the condition type on the referenced resource which
+	// must have status True for the gate to be met. Defaults to "Ready".
+	// +optional
+	ConditionType string `json:"conditionType,omitempty"`
+
+	// Endpoint is a URL which must return a 2xx response for the gate to be
+	// met. Unlike the peer confirmation, an unreachable endpoint keeps the
+	// gate unmet, erring on the side of not remediating during an incident.
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// PeerConfirmation holds the configuration of a peer endpoint which confirms
+// unhealthy nodes before they get remediated.
+type PeerConfirmation struct {
+	// Endpoint is the URL of the peer. The node name is passed as the "node"
+	// query parameter. A 2xx response confirms the node as unhealthy, a 409
+	// response means the peer considers the node healthy.
+	// +kubebuilder:validation:MinLength=1
+	Endpoint string `json:"endpoint"`
+
+	// Timeout for waiting for the peer response. When the peer doesn't answer
+	// in time, remediation proceeds as if no peer was configured.
+	// +kubebuilder:default:="5s"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// NodeResourceRef identifies a node-like resource kind by group, version and kind.
+type NodeResourceRef struct {
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+}
+
+// ResourceAvailabilityCondition marks a node as unhealthy when the allocatable
+// amount of the named resource stays below the minimum for the given duration.
+// A resource missing from node.Status.Allocatable counts as zero.
+type ResourceAvailabilityCondition struct {
+	// ResourceName of the node resource to watch, e.g. "nvidia.com/gpu".
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:MinLength=1
+	ResourceName corev1.ResourceName `json:"resourceName"`
+
+	// MinAllocatable is the minimum allocatable quantity the node has to report
+	// for the resource to be considered healthy.
+	MinAllocatable resource.Quantity `json:"minAllocatable"`
+
+	// Duration for which the allocatable amount has to stay below the minimum
+	// before the node is considered unhealthy. Defaults to considering the node
+	// unhealthy on the first observation.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// PodDisruptionThreshold marks a node as unhealthy when more pods than allowed
+// were OOM-killed on or evicted from it within the window, as observed via the
+// pod statuses of the node's pods.
+type PodDisruptionThreshold struct {
+	// MaxDisruptedPods is the number of OOM kills and evictions attributed to
+	// the node within Window above which the node is considered unhealthy.
+	// +kubebuilder:validation:Minimum=1
+	MaxDisruptedPods int `json:"maxDisruptedPods"`
+
+	// Window is the sliding window the disruptions are counted over.
+	Window metav1.Duration `json:"window"`
 }
 
 // UnhealthyCondition represents a Node condition type and value with a
@@ -94,6 +966,92 @@ type UnhealthyCondition struct {
 	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
 	// +kubebuilder:validation:Type=string
 	Duration metav1.Duration `json:"duration"`
+
+	// Primary marks this condition as the primary readiness signal of the NHC.
+	// When set, the node watch prioritizes enqueueing reconciles for nodes whose
+	// primary condition matches, reducing reconcile noise for clusters which
+	// track many non-critical conditions. At most one condition may be marked
+	// primary.
+	// +optional
+	Primary bool `json:"primary,omitempty"`
+}
+
+// UnhealthyAnnotation represents a Node annotation key and value with a
+// specified duration. When the node has carried the annotation with the given
+// value for at least the duration value the node is considered unhealthy.
+// Since annotation changes don't record a transition time, the duration is
+// measured from the annotation's write time in the node's managed fields when
+// available, and from the controller's first observation otherwise.
+type UnhealthyAnnotation struct {
+	// Key is the annotation key external detectors set on the node,
+	// e.g. "hw.example.com/disk-failed".
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Value the annotation must carry for the node to be considered unhealthy.
+	// +kubebuilder:validation:MinLength=1
+	Value string `json:"value"`
+
+	// Duration the annotation must keep matching before the node is considered
+	// unhealthy. Defaults to considering the node unhealthy on the first
+	// observation.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// UnhealthyTaint marks a node as unhealthy when it carries a matching taint
+// for a duration. Taints mostly lack timestamps, so the duration is measured
+// from the taint's TimeAdded when set and from the controller's first
+// observation otherwise.
+type UnhealthyTaint struct {
+	// Key of the taint, e.g. "node.kubernetes.io/unreachable".
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+
+	// Effect the taint must carry for the node to be considered unhealthy.
+	// Empty matches any effect.
+	// +kubebuilder:validation:Enum=NoSchedule;PreferNoSchedule;NoExecute;""
+	// +optional
+	Effect corev1.TaintEffect `json:"effect,omitempty"`
+
+	// Duration the taint must stay on the node before the node is considered
+	// unhealthy. Defaults to considering the node unhealthy on the first
+	// observation.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// ConditionProfile selects the unhealthy conditions to use for nodes matching
+// a label selector, evaluated per node.
+type ConditionProfile struct {
+	// Selector matches the nodes this profile applies to, by their labels.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// UnhealthyConditions to check the matching nodes against, with the same
+	// semantics as the NHC's base UnhealthyConditions.
+	// +kubebuilder:validation:MinItems=1
+	UnhealthyConditions []UnhealthyCondition `json:"unhealthyConditions"`
+}
+
+// ConditionFlapThreshold bounds how often a node condition may change status
+// within a sliding window before the node is considered unhealthy. Since node
+// conditions only record their last transition time, the controller tracks the
+// transitions it observed.
+type ConditionFlapThreshold struct {
+	// Type of the node condition to watch for flapping, e.g. "MemoryPressure".
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:MinLength=1
+	Type corev1.NodeConditionType `json:"type"`
+
+	// MaxTransitions is the number of status transitions within the window the
+	// condition may undergo before the node is considered unhealthy.
+	// +kubebuilder:validation:Minimum=1
+	MaxTransitions int `json:"maxTransitions"`
+
+	// Window is the sliding window over which the transitions are counted.
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +kubebuilder:validation:Type=string
+	Window metav1.Duration `json:"window"`
 }
 
 // NodeHealthCheckStatus defines the observed state of NodeHealthCheck
@@ -106,13 +1064,90 @@ type NodeHealthCheckStatus struct {
 	//HealthyNodes specified the number of healthy nodes observed
 	HealthyNodes int `json:"healthyNodes,omitempty"`
 
+	// ObservedGeneration is the generation of the spec the controller last
+	// completed a reconcile for. A generation newer than the observed one
+	// means a spec change hasn't been fully processed yet.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is a coarse single-value summary of the NHC's state for dashboards
+	// and list output: "Disabled", "DryRun", "Paused", "Remediating" or
+	// "Enabled". The conditions carry the detailed state.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="inFlightRemediations",xDescriptors="urn:alm:descriptor:com.tectonic.ui:inFlightRemediations"
 	//InFlightRemediations records the timestamp when remediation triggered per node
 	InFlightRemediations map[string]metav1.Time `json:"inFlightRemediations,omitempty"`
 
+	// InFlightRemediationNames lists the nodes with an in-flight remediation,
+	// sorted by name. It duplicates the InFlightRemediations keys in a form
+	// which is readable in kubectl output and backs the In-flight printer
+	// column.
+	// +optional
+	InFlightRemediationNames []string `json:"inFlightRemediationNames,omitempty"`
+
+	// TemplatesInUse records the remediation templates which in-flight remediations
+	// were created from. It keeps old remediation CRs trackable after the NHC's
+	// remediation template was changed mid-flight.
+	// +optional
+	TemplatesInUse []corev1.ObjectReference `json:"templatesInUse,omitempty"`
+
+	// PausedSince records when remediation was paused via PauseRequests, for the
+	// operator-level maximum pause duration guardrail. Unset while no pause
+	// requests are in place.
+	// +optional
+	PausedSince *metav1.Time `json:"pausedSince,omitempty"`
+
+	// OldestRemediationAge is the age of the oldest in-flight remediation, for
+	// alerting on remediations which aren't completing. Unset when no remediation
+	// is in flight.
+	// +optional
+	OldestRemediationAge *metav1.Duration `json:"oldestRemediationAge,omitempty"`
+
+	// RemediatorStats aggregates the completed incidents per remediator kind,
+	// for evaluating remediator reliability over time.
+	// +optional
+	RemediatorStats map[string]RemediatorStat `json:"remediatorStats,omitempty"`
+
+	// RemediationSuccessRate is the share of remediations which ended with a
+	// recovered node over the operator's rolling success rate window, e.g.
+	// "67% (2/3)". "unknown" while no remediation completed within the window.
+	// +optional
+	RemediationSuccessRate string `json:"remediationSuccessRate,omitempty"`
+
+	// RemediationHistory records the remediations this NHC started, newest
+	// last. Completed entries are pruned to the HistoryLimit and HistoryTTL;
+	// entries of in-flight remediations are never pruned.
+	// +optional
+	RemediationHistory []RemediationHistoryEntry `json:"remediationHistory,omitempty"`
+
+	// RemediatedTargetNodes records which nodes of a target node campaign have
+	// been remediated at least once, for disabling the NHC once the campaign
+	// completed.
+	// +optional
+	RemediatedTargetNodes []string `json:"remediatedTargetNodes,omitempty"`
+
+	// NodesUnderVerification lists nodes which turned healthy again after
+	// remediation and are still being verified to stay healthy for the
+	// PostRemediationVerifyPeriod before their remediation is cleaned up.
+	// +optional
+	NodesUnderVerification []string `json:"nodesUnderVerification,omitempty"`
+
+	// ExcludedControlPlaneNodes lists the selected control-plane nodes which are
+	// intentionally left untouched because ExcludeControlPlane is set.
+	// +optional
+	ExcludedControlPlaneNodes []string `json:"excludedControlPlaneNodes,omitempty"`
+
+	// UnhealthyNodes surfaces per-unhealthy-node state, currently the
+	// remediation lease the controller manages for the node, for debugging
+	// lease contention without querying the coordination API directly.
+	// +optional
+	UnhealthyNodes []UnhealthyNode `json:"unhealthyNodes,omitempty"`
+
 	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="conditions",xDescriptors="urn:alm:descriptor:com.tectonic.ui:conditions"
 	// Represents the observations of a NodeHealthCheck's current state.
-	// Known .status.conditions.type are: "Disabled"
+	// Known .status.conditions.type are: "Disabled", "AllNodesHealthy"
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
@@ -121,9 +1156,109 @@ type NodeHealthCheckStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// UnhealthyNode holds the per-node state of an unhealthy node.
+type UnhealthyNode struct {
+	// Name of the node.
+	Name string `json:"name"`
+
+	// Lease describes the remediation lease the controller holds for this node,
+	// when one exists.
+	// +optional
+	Lease *NodeLeaseStatus `json:"lease,omitempty"`
+
+	// Reason explains why remediation of this node is currently deferred, e.g.
+	// "machine deletion in progress", or why it became a candidate without a
+	// failure, e.g. "kubelet version below the minimum". Empty while
+	// remediation of a failed node proceeds normally.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// DrainedPods counts the pods the pre-remediation drain evicted from this
+	// node. Only set with a drain policy other than "None".
+	// +optional
+	DrainedPods int `json:"drainedPods,omitempty"`
+
+	// Remediations lists the remediation CRs intended for this node. Currently
+	// only populated in dry-run mode, where the controller records the CR it
+	// would have created as a synthetic entry flagged DryRun instead of
+	// creating it.
+	// +optional
+	Remediations []Remediation `json:"remediations,omitempty"`
+}
+
+// Remediation describes a remediation CR of an unhealthy node, or the CR a
+// dry run would have created.
+type Remediation struct {
+	// Kind of the remediation CR.
+	Kind string `json:"kind"`
+
+	// Name of the remediation CR.
+	Name string `json:"name"`
+
+	// Namespace of the remediation CR.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// DryRun marks a synthetic entry: the CR was computed but never created.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// NodeLeaseStatus surfaces the remediation lease of an unhealthy node.
+type NodeLeaseStatus struct {
+	// Holder is the lease's holder identity.
+	// +optional
+	Holder string `json:"holder,omitempty"`
+
+	// AcquiredAt is when the lease was acquired.
+	// +optional
+	AcquiredAt *metav1.Time `json:"acquiredAt,omitempty"`
+
+	// ExpiresAt is when the lease expires unless it is renewed.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// RemediationHistoryEntry records one remediation the NHC started for a node.
+type RemediationHistoryEntry struct {
+	// Node is the name of the remediated node.
+	Node string `json:"node"`
+
+	// Remediator is the kind of the remediation CR, identifying the remediator
+	// which handled this entry.
+	// +optional
+	Remediator string `json:"remediator,omitempty"`
+
+	// StartedAt is when the remediation CR was created.
+	StartedAt metav1.Time `json:"startedAt"`
+
+	// CompletedAt is when the remediation was cleaned up again. Unset while
+	// the remediation is still in flight.
+	// +optional
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// Outcome reports how the remediation ended, e.g. "recovered", "failed"
+	// or "exhausted". Empty while the remediation is still in flight.
+	// +optional
+	Outcome string `json:"outcome,omitempty"`
+}
+
+// RemediatorStat aggregates the completed incidents a remediator kind handled.
+type RemediatorStat struct {
+	// Succeeded counts incidents where the node turned healthy again while this
+	// remediator's remediation was in place.
+	// +optional
+	Succeeded int `json:"succeeded,omitempty"`
+
+	// Failed counts incidents where this remediator reported a terminal failure.
+	// +optional
+	Failed int `json:"failed,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=nodehealthchecks,scope=Cluster,shortName=nhc
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="In-flight",type="string",JSONPath=".status.inFlightRemediationNames",description="Nodes with an in-flight remediation"
 
 // NodeHealthCheck is the Schema for the nodehealthchecks API
 // +operator-sdk:csv:customresourcedefinitions:resources={{"NodeHealthCheck","v1alpha1","nodehealthchecks"}}
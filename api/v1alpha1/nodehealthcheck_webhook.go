@@ -0,0 +1,287 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// ConfirmAllNodesAnnotationKey is the annotation which confirms that an NHC
+// with an empty selector is really meant to target all nodes in the cluster.
+const ConfirmAllNodesAnnotationKey = "remediation.medik8s.io/confirm-all-nodes"
+
+// AllowAllNodeSelectors disables the all-node selector validation, for users
+// who rely on cluster-wide NHCs without annotating them. It is set from an
+// operator flag before the webhook server starts.
+var AllowAllNodeSelectors = false
+
+// DefaultUnhealthyConditions is an operator-level default for UnhealthyConditions,
+// applied by the mutating webhook to NHCs which don't set their own. It is set
+// from an operator flag before the webhook server starts. When empty the
+// built-in defaults apply instead.
+var DefaultUnhealthyConditions []UnhealthyCondition
+
+// builtinDefaultUnhealthyConditions is the built-in default for
+// UnhealthyConditions. Defaulting happens here exclusively, not at the CRD
+// level: a CRD default reaches the webhook before it runs, making it
+// indistinguishable from the user explicitly choosing the same conditions.
+var builtinDefaultUnhealthyConditions = []UnhealthyCondition{
+	{Type: corev1.NodeReady, Status: corev1.ConditionFalse, Duration: metav1.Duration{Duration: 300 * time.Second}},
+	{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Duration: metav1.Duration{Duration: 300 * time.Second}},
+}
+
+var nodehealthchecklog = logf.Log.WithName("nodehealthcheck-resource")
+
+// SetupWebhookWithManager sets up the webhook with the Manager.
+func (nhc *NodeHealthCheck) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(nhc).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-remediation-medik8s-io-v1alpha1-nodehealthcheck,mutating=true,failurePolicy=fail,sideEffects=None,groups=remediation.medik8s.io,resources=nodehealthchecks,verbs=create;update,versions=v1alpha1,name=mnodehealthcheck.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &NodeHealthCheck{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (nhc *NodeHealthCheck) Default() {
+	if len(nhc.Spec.UnhealthyConditions) > 0 {
+		return
+	}
+	defaults := DefaultUnhealthyConditions
+	if len(defaults) == 0 {
+		defaults = builtinDefaultUnhealthyConditions
+	}
+	nodehealthchecklog.Info("applying the default unhealthy conditions", "name", nhc.Name)
+	nhc.Spec.UnhealthyConditions = append([]UnhealthyCondition{}, defaults...)
+}
+
+//+kubebuilder:webhook:path=/validate-remediation-medik8s-io-v1alpha1-nodehealthcheck,mutating=false,failurePolicy=fail,sideEffects=None,groups=remediation.medik8s.io,resources=nodehealthchecks,verbs=create;update,versions=v1alpha1,name=vnodehealthcheck.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &NodeHealthCheck{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (nhc *NodeHealthCheck) ValidateCreate() error {
+	nodehealthchecklog.Info("validate create", "name", nhc.Name)
+	return nhc.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (nhc *NodeHealthCheck) ValidateUpdate(old runtime.Object) error {
+	nodehealthchecklog.Info("validate update", "name", nhc.Name)
+	return nhc.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (nhc *NodeHealthCheck) ValidateDelete() error {
+	return nil
+}
+
+func (nhc *NodeHealthCheck) validate() error {
+	for _, warning := range NodeHealthCheckWarnings(nhc) {
+		nodehealthchecklog.Info("validation warning", "name", nhc.Name, "warning", warning)
+	}
+	if errs := ValidateNodeHealthCheck(nhc); len(errs) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("NodeHealthCheck").GroupKind(), nhc.Name, errs)
+	}
+	return nil
+}
+
+// NodeHealthCheckWarnings returns non-fatal findings about the NHC, which the
+// webhook logs without rejecting the object. Currently it flags escalating
+// remediation steps whose timeout is shorter than an earlier step's, which
+// works but is usually a configuration mistake: the heavier remediation gets
+// less time than the lighter one it escalated from.
+func NodeHealthCheckWarnings(nhc *NodeHealthCheck) []string {
+	escalations := append([]EscalatingRemediation{}, nhc.Spec.EscalatingRemediations...)
+	sort.Slice(escalations, func(i, j int) bool { return escalations[i].Order < escalations[j].Order })
+
+	var warnings []string
+	for i := 1; i < len(escalations); i++ {
+		if escalations[i].Timeout.Duration > 0 && escalations[i-1].Timeout.Duration > 0 &&
+			escalations[i].Timeout.Duration < escalations[i-1].Timeout.Duration {
+			warnings = append(warnings, fmt.Sprintf(
+				"escalating remediation with order %d has a shorter timeout (%s) than the previous step with order %d (%s)",
+				escalations[i].Order, escalations[i].Timeout.Duration,
+				escalations[i-1].Order, escalations[i-1].Timeout.Duration))
+		}
+	}
+	return warnings
+}
+
+// ValidateNodeHealthCheck validates the NHC the same way the admission webhook
+// does. It is a pure function so CI and CLI tooling can validate manifests
+// offline, without a running cluster.
+func ValidateNodeHealthCheck(nhc *NodeHealthCheck) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	errs = append(errs, validateSelectorNotAllNodes(nhc, specPath.Child("selector"))...)
+	errs = append(errs, validateMinHealthy(nhc.Spec.MinHealthy, specPath.Child("minHealthy"))...)
+
+	if nhc.Spec.ExpectedNodes != nil && *nhc.Spec.ExpectedNodes < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("expectedNodes"),
+			*nhc.Spec.ExpectedNodes, "expectedNodes must not be negative"))
+	}
+	if nhc.Spec.ExpectedNodesTolerance != nil && *nhc.Spec.ExpectedNodesTolerance < 0 {
+		errs = append(errs, field.Invalid(specPath.Child("expectedNodesTolerance"),
+			*nhc.Spec.ExpectedNodesTolerance, "expectedNodesTolerance must not be negative"))
+	}
+
+	if nhc.Spec.RemediationTemplate == nil && nhc.Spec.RemediationJobTemplate == nil &&
+		len(nhc.Spec.EscalatingRemediations) == 0 && nhc.Spec.InheritFrom == nil {
+		errs = append(errs, field.Required(specPath.Child("remediationTemplate"),
+			"either a remediation template or a remediation job template is required"))
+	}
+	if nhc.Spec.InheritFrom != nil && nhc.Spec.InheritFrom.Name == nhc.Name {
+		errs = append(errs, field.Invalid(specPath.Child("inheritFrom").Child("name"),
+			nhc.Spec.InheritFrom.Name, "an NHC cannot inherit from itself"))
+	}
+	if nhc.Spec.RemediationTemplate != nil && nhc.Spec.RemediationJobTemplate != nil {
+		errs = append(errs, field.Forbidden(specPath.Child("remediationJobTemplate"),
+			"remediationJobTemplate and remediationTemplate are mutually exclusive"))
+	}
+	if nhc.Spec.RemediationTemplate != nil && len(nhc.Spec.EscalatingRemediations) > 0 {
+		errs = append(errs, field.Forbidden(specPath.Child("escalatingRemediations"),
+			"escalatingRemediations and remediationTemplate are mutually exclusive"))
+	}
+
+	primaries := 0
+	for i, condition := range nhc.Spec.UnhealthyConditions {
+		if condition.Duration.Duration < 0 {
+			errs = append(errs, field.Invalid(specPath.Child("unhealthyConditions").Index(i).Child("duration"),
+				condition.Duration.Duration.String(), "duration must not be negative"))
+		}
+		if condition.Primary {
+			if primaries++; primaries > 1 {
+				errs = append(errs, field.Invalid(specPath.Child("unhealthyConditions").Index(i).Child("primary"),
+					condition.Primary, "at most one unhealthy condition may be marked primary"))
+			}
+		}
+	}
+
+	if interval := nhc.Spec.DeferredRequeueInterval; interval != nil && interval.Duration < 5*time.Second {
+		errs = append(errs, field.Invalid(specPath.Child("deferredRequeueInterval"),
+			interval.Duration.String(), "deferredRequeueInterval must be at least 5s"))
+	}
+
+	if timeout := nhc.Spec.RemediationCRAlertTimeout; timeout != nil && timeout.Duration < time.Minute {
+		errs = append(errs, field.Invalid(specPath.Child("remediationCRAlertTimeout"),
+			timeout.Duration.String(), "remediationCRAlertTimeout must be at least 1m"))
+	}
+
+	if mapping := nhc.Spec.RemediationConditionMapping; mapping != nil {
+		mappingPath := specPath.Child("remediationConditionMapping")
+		if mapping.SucceededType == "" {
+			errs = append(errs, field.Required(mappingPath.Child("succeededType"),
+				"succeededType must not be empty when the mapping is set"))
+		}
+		if mapping.ProcessingType == "" {
+			errs = append(errs, field.Required(mappingPath.Child("processingType"),
+				"processingType must not be empty when the mapping is set"))
+		}
+	}
+
+	orders := map[int]bool{}
+	for i, escalation := range nhc.Spec.EscalatingRemediations {
+		escalationPath := specPath.Child("escalatingRemediations").Index(i)
+		if orders[escalation.Order] {
+			errs = append(errs, field.Duplicate(escalationPath.Child("order"), escalation.Order))
+		}
+		orders[escalation.Order] = true
+		if escalation.Timeout.Duration <= 0 {
+			errs = append(errs, field.Invalid(escalationPath.Child("timeout"),
+				escalation.Timeout.Duration.String(), "timeout must be positive"))
+		}
+	}
+
+	return errs
+}
+
+// validateMinHealthy checks the bounds of MinHealthy, mirroring the CRD-level
+// pattern for offline validation.
+func validateMinHealthy(minHealthy *intstr.IntOrString, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if minHealthy == nil {
+		return errs
+	}
+	switch minHealthy.Type {
+	case intstr.Int:
+		if minHealthy.IntValue() < 0 {
+			errs = append(errs, field.Invalid(path, minHealthy.String(), "minHealthy must not be negative"))
+		}
+	case intstr.String:
+		percent, err := strconv.Atoi(strings.TrimSuffix(minHealthy.StrVal, "%"))
+		if !strings.HasSuffix(minHealthy.StrVal, "%") || err != nil || percent < 0 || percent > 100 {
+			errs = append(errs, field.Invalid(path, minHealthy.String(), "minHealthy must be a whole percentage between 0% and 100%"))
+		}
+	}
+	return errs
+}
+
+// validateSelectorNotAllNodes rejects empty selectors, which match every node in
+// the cluster, unless that was confirmed explicitly via annotation.
+func validateSelectorNotAllNodes(nhc *NodeHealthCheck, path *field.Path) field.ErrorList {
+	if AllowAllNodeSelectors {
+		return nil
+	}
+	if len(nhc.Spec.Selector.MatchLabels) > 0 || len(nhc.Spec.Selector.MatchExpressions) > 0 {
+		return nil
+	}
+	if nhc.GetAnnotations()[ConfirmAllNodesAnnotationKey] == "true" {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(path,
+		fmt.Sprintf("the empty selector matches all nodes in the cluster; set the %q annotation to \"true\" to confirm this is intended", ConfirmAllNodesAnnotationKey))}
+}
+
+// ParseUnhealthyConditions parses a comma separated list of "Type:Status:Duration"
+// entries, e.g. "Ready:False:600s,Ready:Unknown:600s", as used by the flag feeding
+// DefaultUnhealthyConditions.
+func ParseUnhealthyConditions(value string) ([]UnhealthyCondition, error) {
+	var conditions []UnhealthyCondition
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid unhealthy condition %q, expected the \"Type:Status:Duration\" format", entry)
+		}
+		duration, err := time.ParseDuration(parts[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration in unhealthy condition %q: %v", entry, err)
+		}
+		conditions = append(conditions, UnhealthyCondition{
+			Type:     corev1.NodeConditionType(parts[0]),
+			Status:   corev1.ConditionStatus(parts[1]),
+			Duration: metav1.Duration{Duration: duration},
+		})
+	}
+	return conditions, nil
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("NodeHealthCheck Validation", func() {
+
+	newNHC := func(escalations ...EscalatingRemediation) *NodeHealthCheck {
+		return &NodeHealthCheck{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: NodeHealthCheckSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"node-role.kubernetes.io/worker": ""},
+				},
+				EscalatingRemediations: escalations,
+			},
+		}
+	}
+
+	newEscalation := func(order int, timeout time.Duration) EscalatingRemediation {
+		return EscalatingRemediation{
+			RemediationTemplate: corev1.ObjectReference{
+				APIVersion: "test.medik8s.io/v1alpha1",
+				Kind:       "TestRemediationTemplate",
+				Name:       "test",
+				Namespace:  "default",
+			},
+			Order:   order,
+			Timeout: metav1.Duration{Duration: timeout},
+		}
+	}
+
+	Context("escalating remediation timeouts", func() {
+
+		It("should accept positive timeouts", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute), newEscalation(2, 10*time.Minute))
+			Expect(ValidateNodeHealthCheck(nhc)).To(BeEmpty())
+		})
+
+		It("should reject a zero timeout", func() {
+			nhc := newNHC(newEscalation(1, 0))
+			errs := ValidateNodeHealthCheck(nhc)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.escalatingRemediations[0].timeout"))
+		})
+
+		It("should reject a negative timeout", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute), newEscalation(2, -1*time.Minute))
+			errs := ValidateNodeHealthCheck(nhc)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.escalatingRemediations[1].timeout"))
+		})
+	})
+
+	Context("remediation condition mapping", func() {
+
+		It("should accept a fully specified mapping", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute))
+			nhc.Spec.RemediationConditionMapping = &RemediationConditionMapping{
+				SucceededType:  "Fenced",
+				ProcessingType: "Fencing",
+				FailedType:     "FencingFailed",
+			}
+			Expect(ValidateNodeHealthCheck(nhc)).To(BeEmpty())
+		})
+
+		It("should reject empty condition types", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute))
+			nhc.Spec.RemediationConditionMapping = &RemediationConditionMapping{}
+			errs := ValidateNodeHealthCheck(nhc)
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Field).To(Equal("spec.remediationConditionMapping.succeededType"))
+			Expect(errs[1].Field).To(Equal("spec.remediationConditionMapping.processingType"))
+		})
+	})
+
+	Context("remediation CR alert timeout", func() {
+
+		It("should accept a timeout of at least one minute", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute))
+			nhc.Spec.RemediationCRAlertTimeout = &metav1.Duration{Duration: time.Minute}
+			Expect(ValidateNodeHealthCheck(nhc)).To(BeEmpty())
+		})
+
+		It("should reject a shorter timeout", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute))
+			nhc.Spec.RemediationCRAlertTimeout = &metav1.Duration{Duration: 30 * time.Second}
+			errs := ValidateNodeHealthCheck(nhc)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.remediationCRAlertTimeout"))
+		})
+	})
+
+	Context("deferred requeue interval", func() {
+
+		It("should accept an interval of at least 5 seconds", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute))
+			nhc.Spec.DeferredRequeueInterval = &metav1.Duration{Duration: 5 * time.Second}
+			Expect(ValidateNodeHealthCheck(nhc)).To(BeEmpty())
+		})
+
+		It("should reject a shorter interval", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute))
+			nhc.Spec.DeferredRequeueInterval = &metav1.Duration{Duration: time.Second}
+			errs := ValidateNodeHealthCheck(nhc)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal("spec.deferredRequeueInterval"))
+		})
+	})
+
+	Context("escalating remediation timeout ordering warnings", func() {
+
+		It("should not warn when the timeouts don't shrink", func() {
+			nhc := newNHC(newEscalation(1, 5*time.Minute), newEscalation(2, 5*time.Minute), newEscalation(3, 10*time.Minute))
+			Expect(NodeHealthCheckWarnings(nhc)).To(BeEmpty())
+		})
+
+		It("should warn when a later step has a shorter timeout", func() {
+			nhc := newNHC(newEscalation(1, 10*time.Minute), newEscalation(2, 5*time.Minute))
+			warnings := NodeHealthCheckWarnings(nhc)
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0]).To(ContainSubstring("order 2"))
+		})
+
+		It("should compare the steps in order regardless of their list position", func() {
+			nhc := newNHC(newEscalation(2, 5*time.Minute), newEscalation(1, 10*time.Minute))
+			warnings := NodeHealthCheckWarnings(nhc)
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0]).To(ContainSubstring("order 2"))
+		})
+
+		It("should not warn for zero timeouts, which are rejected separately", func() {
+			nhc := newNHC(newEscalation(1, 10*time.Minute), newEscalation(2, 0))
+			Expect(NodeHealthCheckWarnings(nhc)).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("NodeHealthCheck Defaulting", func() {
+
+	var nhc *NodeHealthCheck
+
+	BeforeEach(func() {
+		nhc = &NodeHealthCheck{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+	})
+
+	AfterEach(func() {
+		DefaultUnhealthyConditions = nil
+	})
+
+	It("should apply the built-in default conditions when none are set", func() {
+		nhc.Default()
+		Expect(nhc.Spec.UnhealthyConditions).To(Equal(builtinDefaultUnhealthyConditions))
+	})
+
+	It("should prefer the operator-level default conditions when configured", func() {
+		DefaultUnhealthyConditions = []UnhealthyCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Duration: metav1.Duration{Duration: 10 * time.Minute}},
+		}
+		nhc.Default()
+		Expect(nhc.Spec.UnhealthyConditions).To(Equal(DefaultUnhealthyConditions))
+	})
+
+	It("should keep explicitly chosen conditions, even when they equal the built-in default", func() {
+		DefaultUnhealthyConditions = []UnhealthyCondition{
+			{Type: corev1.NodeReady, Status: corev1.ConditionUnknown, Duration: metav1.Duration{Duration: 10 * time.Minute}},
+		}
+		chosen := append([]UnhealthyCondition{}, builtinDefaultUnhealthyConditions...)
+		nhc.Spec.UnhealthyConditions = chosen
+		nhc.Default()
+		Expect(nhc.Spec.UnhealthyConditions).To(Equal(chosen))
+	})
+})
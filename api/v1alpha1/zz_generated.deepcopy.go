@@ -22,12 +22,118 @@ limitations under the License.
 package v1alpha1
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionFlapThreshold) DeepCopyInto(out *ConditionFlapThreshold) {
+	*out = *in
+	out.Window = in.Window
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionFlapThreshold.
+func (in *ConditionFlapThreshold) DeepCopy() *ConditionFlapThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionFlapThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionProfile) DeepCopyInto(out *ConditionProfile) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.UnhealthyConditions != nil {
+		in, out := &in.UnhealthyConditions, &out.UnhealthyConditions
+		*out = make([]UnhealthyCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionProfile.
+func (in *ConditionProfile) DeepCopy() *ConditionProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalatingRemediation) DeepCopyInto(out *EscalatingRemediation) {
+	*out = *in
+	out.RemediationTemplate = in.RemediationTemplate
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalatingRemediation.
+func (in *EscalatingRemediation) DeepCopy() *EscalatingRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalatingRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPProbe) DeepCopyInto(out *HTTPProbe) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPProbe.
+func (in *HTTPProbe) DeepCopy() *HTTPProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkIsolationSafeguard) DeepCopyInto(out *NetworkIsolationSafeguard) {
+	*out = *in
+	out.UnhealthyRatioAbort = in.UnhealthyRatioAbort
+	if in.CorrelationWindow != nil {
+		in, out := &in.CorrelationWindow, &out.CorrelationWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.APIConnectivityCooldown != nil {
+		in, out := &in.APIConnectivityCooldown, &out.APIConnectivityCooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkIsolationSafeguard.
+func (in *NetworkIsolationSafeguard) DeepCopy() *NetworkIsolationSafeguard {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkIsolationSafeguard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeHealthCheck) DeepCopyInto(out *NodeHealthCheck) {
 	*out = *in
@@ -96,21 +202,196 @@ func (in *NodeHealthCheckSpec) DeepCopyInto(out *NodeHealthCheckSpec) {
 		*out = make([]UnhealthyCondition, len(*in))
 		copy(*out, *in)
 	}
+	if in.UnhealthyAnnotations != nil {
+		in, out := &in.UnhealthyAnnotations, &out.UnhealthyAnnotations
+		*out = make([]UnhealthyAnnotation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnhealthyTaints != nil {
+		in, out := &in.UnhealthyTaints, &out.UnhealthyTaints
+		*out = make([]UnhealthyTaint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConditionProfiles != nil {
+		in, out := &in.ConditionProfiles, &out.ConditionProfiles
+		*out = make([]ConditionProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConditionFlapThresholds != nil {
+		in, out := &in.ConditionFlapThresholds, &out.ConditionFlapThresholds
+		*out = make([]ConditionFlapThreshold, len(*in))
+		copy(*out, *in)
+	}
 	if in.MinHealthy != nil {
 		in, out := &in.MinHealthy, &out.MinHealthy
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.ExpectedNodes != nil {
+		in, out := &in.ExpectedNodes, &out.ExpectedNodes
+		*out = new(int)
+		**out = **in
+	}
+	if in.ExpectedNodesTolerance != nil {
+		in, out := &in.ExpectedNodesTolerance, &out.ExpectedNodesTolerance
+		*out = new(int)
+		**out = **in
+	}
 	if in.RemediationTemplate != nil {
 		in, out := &in.RemediationTemplate, &out.RemediationTemplate
 		*out = new(v1.ObjectReference)
 		**out = **in
 	}
+	if in.RemediationJobTemplate != nil {
+		in, out := &in.RemediationJobTemplate, &out.RemediationJobTemplate
+		*out = new(batchv1.JobTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScheduledMinHealthy != nil {
+		in, out := &in.ScheduledMinHealthy, &out.ScheduledMinHealthy
+		*out = make([]ScheduledMinHealthy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.PauseRequests != nil {
 		in, out := &in.PauseRequests, &out.PauseRequests
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.NodeResourceRef != nil {
+		in, out := &in.NodeResourceRef, &out.NodeResourceRef
+		*out = new(NodeResourceRef)
+		**out = **in
+	}
+	if in.PeerConfirmation != nil {
+		in, out := &in.PeerConfirmation, &out.PeerConfirmation
+		*out = new(PeerConfirmation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrerequisiteHealthGate != nil {
+		in, out := &in.PrerequisiteHealthGate, &out.PrerequisiteHealthGate
+		*out = new(PrerequisiteHealthGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EscalatingRemediations != nil {
+		in, out := &in.EscalatingRemediations, &out.EscalatingRemediations
+		*out = make([]EscalatingRemediation, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemediationByNodeInfo != nil {
+		in, out := &in.RemediationByNodeInfo, &out.RemediationByNodeInfo
+		*out = make([]RemediationByNodeInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemediationOwnerReference != nil {
+		in, out := &in.RemediationOwnerReference, &out.RemediationOwnerReference
+		*out = new(RemediationOwnerReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostRemediationVerifyPeriod != nil {
+		in, out := &in.PostRemediationVerifyPeriod, &out.PostRemediationVerifyPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.HTTPProbe != nil {
+		in, out := &in.HTTPProbe, &out.HTTPProbe
+		*out = new(HTTPProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConcurrentRemediations != nil {
+		in, out := &in.MaxConcurrentRemediations, &out.MaxConcurrentRemediations
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.TerminalFailureConditionTypes != nil {
+		in, out := &in.TerminalFailureConditionTypes, &out.TerminalFailureConditionTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemediationConditionMapping != nil {
+		in, out := &in.RemediationConditionMapping, &out.RemediationConditionMapping
+		*out = new(RemediationConditionMapping)
+		**out = **in
+	}
+	if in.AutoApproveTimeout != nil {
+		in, out := &in.AutoApproveTimeout, &out.AutoApproveTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RemediationCRAlertTimeout != nil {
+		in, out := &in.RemediationCRAlertTimeout, &out.RemediationCRAlertTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PreRemediationDrainPriorityThreshold != nil {
+		in, out := &in.PreRemediationDrainPriorityThreshold, &out.PreRemediationDrainPriorityThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(int)
+		**out = **in
+	}
+	if in.HistoryTTL != nil {
+		in, out := &in.HistoryTTL, &out.HistoryTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MinDelayBetweenRemediations != nil {
+		in, out := &in.MinDelayBetweenRemediations, &out.MinDelayBetweenRemediations
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DeferredRequeueInterval != nil {
+		in, out := &in.DeferredRequeueInterval, &out.DeferredRequeueInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ResourceAvailabilityConditions != nil {
+		in, out := &in.ResourceAvailabilityConditions, &out.ResourceAvailabilityConditions
+		*out = make([]ResourceAvailabilityCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionThreshold != nil {
+		in, out := &in.PodDisruptionThreshold, &out.PodDisruptionThreshold
+		*out = new(PodDisruptionThreshold)
+		**out = **in
+	}
+	if in.MinKubeletVersionDuration != nil {
+		in, out := &in.MinKubeletVersionDuration, &out.MinKubeletVersionDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.WorkloadNamespaces != nil {
+		in, out := &in.WorkloadNamespaces, &out.WorkloadNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetNodes != nil {
+		in, out := &in.TargetNodes, &out.TargetNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InheritFrom != nil {
+		in, out := &in.InheritFrom, &out.InheritFrom
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.NetworkIsolationSafeguard != nil {
+		in, out := &in.NetworkIsolationSafeguard, &out.NetworkIsolationSafeguard
+		*out = new(NetworkIsolationSafeguard)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeHealthCheckSpec.
@@ -133,6 +414,61 @@ func (in *NodeHealthCheckStatus) DeepCopyInto(out *NodeHealthCheckStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.InFlightRemediationNames != nil {
+		in, out := &in.InFlightRemediationNames, &out.InFlightRemediationNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplatesInUse != nil {
+		in, out := &in.TemplatesInUse, &out.TemplatesInUse
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PausedSince != nil {
+		in, out := &in.PausedSince, &out.PausedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.OldestRemediationAge != nil {
+		in, out := &in.OldestRemediationAge, &out.OldestRemediationAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RemediatorStats != nil {
+		in, out := &in.RemediatorStats, &out.RemediatorStats
+		*out = make(map[string]RemediatorStat, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RemediationHistory != nil {
+		in, out := &in.RemediationHistory, &out.RemediationHistory
+		*out = make([]RemediationHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RemediatedTargetNodes != nil {
+		in, out := &in.RemediatedTargetNodes, &out.RemediatedTargetNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodesUnderVerification != nil {
+		in, out := &in.NodesUnderVerification, &out.NodesUnderVerification
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedControlPlaneNodes != nil {
+		in, out := &in.ExcludedControlPlaneNodes, &out.ExcludedControlPlaneNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnhealthyNodes != nil {
+		in, out := &in.UnhealthyNodes, &out.UnhealthyNodes
+		*out = make([]UnhealthyNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -152,6 +488,267 @@ func (in *NodeHealthCheckStatus) DeepCopy() *NodeHealthCheckStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLeaseStatus) DeepCopyInto(out *NodeLeaseStatus) {
+	*out = *in
+	if in.AcquiredAt != nil {
+		in, out := &in.AcquiredAt, &out.AcquiredAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLeaseStatus.
+func (in *NodeLeaseStatus) DeepCopy() *NodeLeaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLeaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeResourceRef) DeepCopyInto(out *NodeResourceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeResourceRef.
+func (in *NodeResourceRef) DeepCopy() *NodeResourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeResourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeerConfirmation) DeepCopyInto(out *PeerConfirmation) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerConfirmation.
+func (in *PeerConfirmation) DeepCopy() *PeerConfirmation {
+	if in == nil {
+		return nil
+	}
+	out := new(PeerConfirmation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionThreshold) DeepCopyInto(out *PodDisruptionThreshold) {
+	*out = *in
+	out.Window = in.Window
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionThreshold.
+func (in *PodDisruptionThreshold) DeepCopy() *PodDisruptionThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrerequisiteHealthGate) DeepCopyInto(out *PrerequisiteHealthGate) {
+	*out = *in
+	if in.ResourceRef != nil {
+		in, out := &in.ResourceRef, &out.ResourceRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrerequisiteHealthGate.
+func (in *PrerequisiteHealthGate) DeepCopy() *PrerequisiteHealthGate {
+	if in == nil {
+		return nil
+	}
+	out := new(PrerequisiteHealthGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Remediation) DeepCopyInto(out *Remediation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Remediation.
+func (in *Remediation) DeepCopy() *Remediation {
+	if in == nil {
+		return nil
+	}
+	out := new(Remediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationByNodeInfo) DeepCopyInto(out *RemediationByNodeInfo) {
+	*out = *in
+	out.RemediationTemplate = in.RemediationTemplate
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationByNodeInfo.
+func (in *RemediationByNodeInfo) DeepCopy() *RemediationByNodeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationByNodeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationConditionMapping) DeepCopyInto(out *RemediationConditionMapping) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationConditionMapping.
+func (in *RemediationConditionMapping) DeepCopy() *RemediationConditionMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationConditionMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationHistoryEntry) DeepCopyInto(out *RemediationHistoryEntry) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationHistoryEntry.
+func (in *RemediationHistoryEntry) DeepCopy() *RemediationHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediationOwnerReference) DeepCopyInto(out *RemediationOwnerReference) {
+	*out = *in
+	if in.Controller != nil {
+		in, out := &in.Controller, &out.Controller
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockOwnerDeletion != nil {
+		in, out := &in.BlockOwnerDeletion, &out.BlockOwnerDeletion
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediationOwnerReference.
+func (in *RemediationOwnerReference) DeepCopy() *RemediationOwnerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediationOwnerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemediatorStat) DeepCopyInto(out *RemediatorStat) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemediatorStat.
+func (in *RemediatorStat) DeepCopy() *RemediatorStat {
+	if in == nil {
+		return nil
+	}
+	out := new(RemediatorStat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceAvailabilityCondition) DeepCopyInto(out *ResourceAvailabilityCondition) {
+	*out = *in
+	out.MinAllocatable = in.MinAllocatable.DeepCopy()
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceAvailabilityCondition.
+func (in *ResourceAvailabilityCondition) DeepCopy() *ResourceAvailabilityCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceAvailabilityCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledMinHealthy) DeepCopyInto(out *ScheduledMinHealthy) {
+	*out = *in
+	if in.MinHealthy != nil {
+		in, out := &in.MinHealthy, &out.MinHealthy
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledMinHealthy.
+func (in *ScheduledMinHealthy) DeepCopy() *ScheduledMinHealthy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledMinHealthy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyAnnotation) DeepCopyInto(out *UnhealthyAnnotation) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyAnnotation.
+func (in *UnhealthyAnnotation) DeepCopy() *UnhealthyAnnotation {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyAnnotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnhealthyCondition) DeepCopyInto(out *UnhealthyCondition) {
 	*out = *in
@@ -167,3 +764,48 @@ func (in *UnhealthyCondition) DeepCopy() *UnhealthyCondition {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyNode) DeepCopyInto(out *UnhealthyNode) {
+	*out = *in
+	if in.Lease != nil {
+		in, out := &in.Lease, &out.Lease
+		*out = new(NodeLeaseStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Remediations != nil {
+		in, out := &in.Remediations, &out.Remediations
+		*out = make([]Remediation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyNode.
+func (in *UnhealthyNode) DeepCopy() *UnhealthyNode {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyTaint) DeepCopyInto(out *UnhealthyTaint) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyTaint.
+func (in *UnhealthyTaint) DeepCopy() *UnhealthyTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyTaint)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -3,6 +3,7 @@ package cluster
 import (
 	"context"
 	"errors"
+	"strconv"
 
 	"github.com/go-logr/logr"
 
@@ -10,6 +11,8 @@ import (
 
 	clusterversion "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
 	gerrors "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -21,6 +24,10 @@ var unsupportedUpgradeCheckerErr = errors.New(
 	"the cluster doesn't have any upgrade state representation." +
 		" Currently only OpenShift/OKD is supported")
 
+// upgradeStateKey is the ConfigMap data key the ConfigMap based upgrade
+// checker evaluates
+const upgradeStateKey = "upgrading"
+
 // UpgradeChecker checks if the cluster is currently under upgrade.
 // error should be thrown if it can't reliably determine if it's under upgrade or not.
 type UpgradeChecker interface {
@@ -54,6 +61,43 @@ func (o openshiftClusterUpgradeStatusChecker) Check() (bool, error) {
 	return false, nil
 }
 
+// configMapClusterUpgradeStatusChecker reads the upgrade state from a
+// user-managed ConfigMap in the operator's namespace. It is meant for
+// platforms without an OpenShift-like upgrade state representation: whatever
+// drives the upgrade there sets the ConfigMap's "upgrading" key to "true"
+// before starting and back to "false" (or deletes the ConfigMap) when done,
+// and gets the same remediation-skip protection during upgrades.
+type configMapClusterUpgradeStatusChecker struct {
+	client    client.Client
+	namespace string
+	name      string
+	logger    logr.Logger
+}
+
+// force implementation of interface
+var _ UpgradeChecker = configMapClusterUpgradeStatusChecker{}
+
+func (c configMapClusterUpgradeStatusChecker) Check() (bool, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.client.Get(context.Background(), client.ObjectKey{Namespace: c.namespace, Name: c.name}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			// no ConfigMap means no upgrade in progress
+			return false, nil
+		}
+		return false, gerrors.Wrapf(err, "failed to read the upgrade state ConfigMap %s/%s", c.namespace, c.name)
+	}
+	upgrading, err := strconv.ParseBool(cm.Data[upgradeStateKey])
+	if err != nil {
+		// an absent or malformed key means no upgrade in progress
+		return false, nil
+	}
+	if upgrading {
+		c.logger.V(5).Info("cluster is under an upgrade according to the upgrade state ConfigMap",
+			"namespace", c.namespace, "name", c.name)
+	}
+	return upgrading, nil
+}
+
 type noopClusterUpgradeStatusChecker struct {
 }
 
@@ -81,6 +125,22 @@ func NewClusterUpgradeStatusChecker(mgr manager.Manager) (UpgradeChecker, error)
 	return checker, nil
 }
 
+// NewConfigMapClusterUpgradeStatusChecker returns a checker which reads the
+// upgrade state from the named ConfigMap in the operator's namespace, for
+// platforms without a built-in upgrade state representation.
+func NewConfigMapClusterUpgradeStatusChecker(mgr manager.Manager, name string) (UpgradeChecker, error) {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		return nil, gerrors.Wrap(err, "failed to determine the namespace of the upgrade state ConfigMap")
+	}
+	return configMapClusterUpgradeStatusChecker{
+		client:    mgr.GetClient(),
+		namespace: ns,
+		name:      name,
+		logger:    mgr.GetLogger().WithName("ConfigMapClusterUpgradeChecker"),
+	}, nil
+}
+
 func newOpenshiftClusterUpgradeChecker(mgr manager.Manager) (*openshiftClusterUpgradeStatusChecker, error) {
 	configV1Client, err := clusterversion.NewForConfig(mgr.GetConfig())
 	if err != nil {
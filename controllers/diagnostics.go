@@ -0,0 +1,93 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-logr/logr"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	remediationv1alpha1 "github.com/medik8s/node-healthcheck-operator/api/v1alpha1"
+)
+
+// DiagnosticsPath is the path of the diagnostics endpoint on the metrics server.
+const DiagnosticsPath = "/debug/nhc"
+
+// nhcDiagnostics is the diagnostic snapshot of a single NHC, meant for support
+// engineers as a one-shot replacement for multiple kubectl calls.
+type nhcDiagnostics struct {
+	Name                 string                                    `json:"name"`
+	Spec                 remediationv1alpha1.NodeHealthCheckSpec   `json:"spec"`
+	Status               remediationv1alpha1.NodeHealthCheckStatus `json:"status"`
+	MinHealthy           int                                       `json:"minHealthy"`
+	SelectedNodes        []string                                  `json:"selectedNodes"`
+	UnhealthyNodes       []string                                  `json:"unhealthyNodes"`
+	InFlightRemediations map[string]metav1.Time                    `json:"inFlightRemediations"`
+}
+
+// NewDiagnosticsHandler returns an HTTP handler which dumps spec, resolved
+// thresholds, selected and unhealthy node names, and in-flight remediations of
+// all NHCs as a single JSON blob. It shares the live reconciler so the
+// unhealthy set comes from the same composite health evaluation — including
+// condition profiles, annotations, taints, flap detection and probes — and the
+// same tracked observation times the controller acts on.
+func NewDiagnosticsHandler(r *NodeHealthCheckReconciler, logger logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		nhcList := &remediationv1alpha1.NodeHealthCheckList{}
+		if err := r.List(req.Context(), nhcList); err != nil {
+			logger.Error(err, "failed to list NHC objects for the diagnostics endpoint")
+			http.Error(w, "failed to list NHC objects", http.StatusInternalServerError)
+			return
+		}
+
+		diagnostics := make([]nhcDiagnostics, 0, len(nhcList.Items))
+		for i := range nhcList.Items {
+			nhc := &nhcList.Items[i]
+			d := nhcDiagnostics{
+				Name:                 nhc.Name,
+				Spec:                 nhc.Spec,
+				Status:               nhc.Status,
+				SelectedNodes:        []string{},
+				UnhealthyNodes:       []string{},
+				InFlightRemediations: nhc.Status.InFlightRemediations,
+			}
+			nodes, err := r.fetchNodes(req.Context(), nhc)
+			if err != nil {
+				logger.Error(err, "failed to fetch nodes for the diagnostics endpoint", "NHC name", nhc.Name)
+			}
+			for j := range nodes {
+				d.SelectedNodes = append(d.SelectedNodes, nodes[j].Name)
+				if !r.nodeHealthy(nhc, &nodes[j]) {
+					d.UnhealthyNodes = append(d.UnhealthyNodes, nodes[j].Name)
+				}
+			}
+			if minHealthy, err := intstr.GetScaledValueFromIntOrPercent(nhc.Spec.MinHealthy, len(nodes), true); err == nil {
+				d.MinHealthy = minHealthy
+			}
+			diagnostics = append(diagnostics, d)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(diagnostics); err != nil {
+			logger.Error(err, "failed to encode the diagnostics response")
+		}
+	})
+}
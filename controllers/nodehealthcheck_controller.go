@@ -18,47 +18,131 @@ package controllers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"net/http"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+
+	batchv1 "k8s.io/api/batch/v1"
+	coordv1 "k8s.io/api/coordination/v1"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	remediationv1alpha1 "github.com/medik8s/node-healthcheck-operator/api/v1alpha1"
 	"github.com/medik8s/node-healthcheck-operator/controllers/cluster"
 	"github.com/medik8s/node-healthcheck-operator/controllers/mhc"
+	"github.com/medik8s/node-healthcheck-operator/controllers/peer"
+	"github.com/medik8s/node-healthcheck-operator/controllers/resources"
 	"github.com/medik8s/node-healthcheck-operator/controllers/utils"
 	"github.com/medik8s/node-healthcheck-operator/metrics"
 )
 
 const (
-	oldRemediationCRAnnotationKey = "nodehealthcheck.medik8s.io/old-remediation-cr-flag"
-	templateSuffix                = "Template"
-	remediationCRAlertTimeout     = time.Hour * 48
+	oldRemediationCRAnnotationKey  = "nodehealthcheck.medik8s.io/old-remediation-cr-flag"
+	stuckTerminatingAnnotationKey  = "nodehealthcheck.medik8s.io/stuck-terminating-flag"
+	dedupKeyAnnotationKey          = "remediation.medik8s.io/dedup-key"
+	startOrderAnnotationKey        = "remediation.medik8s.io/start-order"
+	timeoutMultiplierAnnotationKey = "remediation.medik8s.io/timeout-multiplier"
+	leaseNamePrefix                = "nhc-"
+	leaseHolderIdentityPrefix      = "NodeHealthCheck/"
+	leaseDurationSeconds           = int32(600)
+	emergencyStopConfigMapName     = "nhc-emergency-stop"
+	underRemediationLabelKey       = "remediation.medik8s.io/under-remediation"
+	nhcGenerationLabelKey          = "nodehealthcheck.medik8s.io/nhc-generation"
+	approvedByAnnotationKey        = "remediation.medik8s.io/approved-by"
+	approvalPendingAnnotationKey   = "remediation.medik8s.io/approval-pending"
+	exhaustedAnnotationKey         = "remediation.medik8s.io/remediation-exhausted"
+	lastRemediationAnnotationKey   = "remediation.medik8s.io/last-remediation"
+	machineAnnotationKey           = "machine.openshift.io/machine"
+	capiMachineAnnotationKey       = "cluster.x-k8s.io/machine"
+	capiMachineNsAnnotationKey     = "cluster.x-k8s.io/cluster-namespace"
+	protectInFlightFinalizer       = "nodehealthcheck.medik8s.io/protect-in-flight"
+	templateSuffix                 = "Template"
+	remediationCRAlertTimeout      = time.Hour * 48
+	stuckTerminatingTimeout        = 10 * time.Minute
+	// maxConcurrentProbes bounds the background workers performing the HTTP
+	// probes, so a large node pool doesn't fan out into a goroutine per node
+	maxConcurrentProbes = 10
+	// probePollInterval is the cadence on which NHCs with an HTTP probe are
+	// re-reconciled, since probe results arrive asynchronously and don't
+	// trigger node events
+	probePollInterval = 1 * time.Minute
+	// defaultHistoryLimit bounds the completed entries of the remediation
+	// history for NHCs which don't configure their own limit
+	defaultHistoryLimit = 10
+	// the conventional medik8s condition types on remediation CRs, used unless
+	// the NHC maps them to the remediator's own names
+	conventionalSucceededConditionType  = "Succeeded"
+	conventionalProcessingConditionType = "Processing"
+	reconcileDebounceInterval           = 2 * time.Second
+	// cacheResyncPeriod mirrors the manager's sync period configured in main
+	cacheResyncPeriod             = 60 * time.Second
 	eventReasonRemediationCreated = "RemediationCreated"
 	eventReasonRemediationSkipped = "RemediationSkipped"
-	eventReasonRemediationRemoved = "RemediationRemoved"
-	eventReasonDisabled           = "Disabled"
-	eventReasonEnabled            = "Enabled"
-	eventTypeNormal               = "Normal"
-	eventTypeWarning              = "Warning"
+	// the skip reasons carry a stable, machine-parseable cause suffix, so alert
+	// rules can match a precise cause without a regex on the free-text message
+	eventReasonSkippedMinHealthy       = eventReasonRemediationSkipped + ":MinHealthy"
+	eventReasonSkippedPaused           = eventReasonRemediationSkipped + ":Paused"
+	eventReasonSkippedUpgrading        = eventReasonRemediationSkipped + ":Upgrading"
+	eventReasonSkippedEmergencyStop    = eventReasonRemediationSkipped + ":EmergencyStop"
+	eventReasonSkippedNetworkIsolation = eventReasonRemediationSkipped + ":NetworkIsolation"
+	eventReasonSkippedMachineDeletion  = eventReasonRemediationSkipped + ":MachineDeletion"
+	eventReasonSkippedAntiAffinity     = eventReasonRemediationSkipped + ":AntiAffinity"
+	eventReasonSkippedConcurrencyCap   = eventReasonRemediationSkipped + ":ConcurrencyCap"
+	eventReasonSkippedPeerHealthy      = eventReasonRemediationSkipped + ":PeerHealthy"
+	eventReasonSkippedApprovalPending  = eventReasonRemediationSkipped + ":ApprovalPending"
+	eventReasonSkippedLease            = eventReasonRemediationSkipped + ":LeaseNotConfirmed"
+	eventReasonSkippedExhausted        = eventReasonRemediationSkipped + ":Exhausted"
+	eventReasonSkippedOperatorNode     = eventReasonRemediationSkipped + ":OperatorNode"
+	eventReasonSkippedHealthGate       = eventReasonRemediationSkipped + ":HealthGate"
+	eventReasonSkippedCanary           = eventReasonRemediationSkipped + ":CanaryInProgress"
+	eventReasonRemediationRemoved      = "RemediationRemoved"
+	eventReasonRemediationFailed       = "RemediationFailed"
+	eventReasonRemediationStuck        = "RemediationStuckTerminating"
+	eventReasonNodeUncordoned          = "NodeUncordoned"
+	eventReasonNodeUnhealthy           = "NodeUnhealthy"
+	eventReasonDryRunRemediation       = "DryRunRemediation"
+	eventReasonQuorumBlocked           = "QuorumBlocked"
+	eventReasonCanaryFailed            = "CanaryFailed"
+	eventReasonDisabled                = "Disabled"
+	eventReasonEnabled                 = "Enabled"
+	eventReasonReconcileSummary        = "ReconcileSummary"
+	eventTypeNormal                    = "Normal"
+	eventTypeWarning                   = "Warning"
 )
 
 // NodeHealthCheckReconciler reconciles a NodeHealthCheck object
@@ -69,20 +153,216 @@ type NodeHealthCheckReconciler struct {
 	Recorder                    record.EventRecorder
 	ClusterUpgradeStatusChecker cluster.UpgradeChecker
 	MHCChecker                  mhc.Checker
+
+	// MaxPauseDuration is a guardrail after which remediation resumes even when
+	// pause requests are still in place. Zero disables the guardrail.
+	MaxPauseDuration time.Duration
+
+	// MHCDisabledRequeueInterval bounds how long the NHC stays dormant while
+	// disabled because of conflicting MHCs, so it re-enables promptly once the
+	// MHCs are gone instead of waiting for the next node event. Zero disables
+	// the periodic re-check.
+	MHCDisabledRequeueInterval time.Duration
+
+	// WatchNamespace scopes the remediation CR operations to a single
+	// namespace, for restricted installs which are only permitted to manage
+	// remediation CRs there. Empty means cluster scope.
+	WatchNamespace string
+
+	// MinReconcileInterval is the minimum time between reconciles of the same
+	// NHC, coalescing rapid node events into one reconcile of the latest
+	// state. Zero means the built-in default.
+	MinReconcileInterval time.Duration
+
+	// PollShortConditionDurations re-checks NHCs whose shortest unhealthy
+	// condition duration is below the cache resync period on the duration's
+	// cadence, so such conditions aren't only noticed on the next resync.
+	PollShortConditionDurations bool
+
+	// ResyncJitter is the maximum random offset added to the requeue intervals
+	// the reconciles compute, spreading the periodic re-checks of many NHCs
+	// over time. Zero disables the jitter.
+	ResyncJitter time.Duration
+
+	// RemoveStuckRemediationFinalizers enables force-removing the finalizers
+	// of remediation CRs whose deletion is stuck for longer than the stuck
+	// terminating timeout, so a wedged remediator doesn't keep its nodes
+	// counted as in-flight remediations forever.
+	RemoveStuckRemediationFinalizers bool
+
+	// NodeListPageSize lists the selected nodes in pages of this size through
+	// the API reader, keeping only the fields the health evaluation needs, to
+	// reduce the per-reconcile memory footprint in very large clusters. Zero
+	// keeps the single cached list.
+	NodeListPageSize int64
+
+	// APIReader reads directly from the API server, bypassing the cache. It
+	// is only used for the paged node listing, since the cache doesn't
+	// support pagination and materializes the full list anyway.
+	APIReader client.Reader
+
+	// ClientSet is only used for the pod eviction subresource of the
+	// pre-remediation drain, which the controller-runtime client doesn't
+	// expose. When nil the drain is skipped.
+	ClientSet kubernetes.Interface
+
+	// clock is the time source for the escalation timeout checks. Tests inject
+	// a fake clock to drive escalation transitions deterministically; when nil
+	// the real clock is used.
+	clock clock.PassiveClock
+
+	// lastReconciles tracks when each NHC was reconciled last, for coalescing
+	// rapid repeated enqueues of the same NHC
+	lastReconciles     map[string]time.Time
+	lastReconcilesLock sync.Mutex
+
+	// probeFailures tracks since when a node's HTTP probe keeps failing
+	probeFailures     map[string]time.Time
+	probeFailuresLock sync.Mutex
+
+	// probeResults caches the latest HTTP probe outcome per node. The probes
+	// run in a bounded number of background workers, so reconciles only read
+	// the cache and never block on slow or unreachable probe endpoints.
+	probeResults     map[string]probeResult
+	probeInFlight    map[string]bool
+	probeWorkers     chan struct{}
+	probeResultsLock sync.Mutex
+
+	// resourceShortages tracks since when a node's allocatable resource amount
+	// stays below the configured minimum
+	resourceShortages     map[string]time.Time
+	resourceShortagesLock sync.Mutex
+
+	// annotationFirstSeen tracks when a matching unhealthy annotation was first
+	// observed on a node; annotation changes don't record a transition time
+	annotationFirstSeen     map[string]time.Time
+	annotationFirstSeenLock sync.Mutex
+
+	// taintFirstSeen tracks when a matching unhealthy taint was first observed
+	// on a node, for the taints which don't carry a TimeAdded timestamp
+	taintFirstSeen     map[string]time.Time
+	taintFirstSeenLock sync.Mutex
+
+	// conditionTransitions remembers the observed transition times of the node
+	// conditions watched by flap thresholds, pruned to the configured window
+	conditionTransitions     map[string][]time.Time
+	conditionTransitionsLock sync.Mutex
+
+	// verifyHealthySince tracks since when a remediated node stays healthy,
+	// for the post remediation verify period
+	verifyHealthySince     map[string]time.Time
+	verifyHealthySinceLock sync.Mutex
+
+	// podDisruptions remembers the observed pod OOM kills and evictions per
+	// nhc/node, pruned to the configured window
+	podDisruptions     map[string]time.Time
+	podDisruptionsLock sync.Mutex
+
+	// kubeletOutdatedSince tracks since when a node's kubelet version is
+	// observed below the NHC's minimum, for the mid-upgrade grace duration
+	kubeletOutdatedSince     map[string]time.Time
+	kubeletOutdatedSinceLock sync.Mutex
+
+	// exhaustedRemediations tracks the nodes whose timed out remediation was
+	// deleted by the Delete timeout policy and must not be retried
+	exhaustedRemediations     map[string]bool
+	exhaustedRemediationsLock sync.Mutex
+
+	// drainedPods counts the pods the pre-remediation drain evicted per
+	// nhc/node, surfaced in the unhealthy node status
+	drainedPods     map[string]int
+	drainedPodsLock sync.Mutex
+
+	// dryRunRemediations remembers the synthetic dry-run entries per nhc/node,
+	// surfaced in the unhealthy node status instead of created CRs
+	dryRunRemediations     map[string][]remediationv1alpha1.Remediation
+	dryRunRemediationsLock sync.Mutex
+
+	// notifiedUnhealthy remembers the tripped conditions the NodeUnhealthy
+	// event was last emitted for per nhc/node, so the event fires once per
+	// unhealthy transition instead of on every reconcile
+	notifiedUnhealthy     map[string]string
+	notifiedUnhealthyLock sync.Mutex
+
+	// remediationOutcomes remembers when remediations completed and whether
+	// they recovered their node, per NHC, pruned to the success rate window
+	remediationOutcomes     map[string][]remediationOutcome
+	remediationOutcomesLock sync.Mutex
+
+	// canaryNodes tracks the node remediated first per NHC while a canary
+	// remediation wave is active, keyed by the NHC's name
+	canaryNodes     map[string]string
+	canaryNodesLock sync.Mutex
+
+	// resultNotifier delivers remediation results to the configured URLs in the
+	// background, created lazily on first use
+	resultNotifier     *notifier
+	resultNotifierLock sync.Mutex
+
+	// apiErrorTime and apiReconnectTime track when the operator lost and regained
+	// API connectivity, for the network isolation cooldown
+	apiErrorTime     time.Time
+	apiReconnectTime time.Time
+	apiHealthLock    sync.Mutex
+
+	// lastRemediationCreates tracks when each NHC last created a remediation CR,
+	// for spacing out CR creations
+	lastRemediationCreates     map[string]time.Time
+	lastRemediationCreatesLock sync.Mutex
+
+	// pendingRemediatorStats collects per NHC the stats of incidents completed
+	// since the last status patch, keyed by remediator kind
+	pendingRemediatorStats     map[string]map[string]remediationv1alpha1.RemediatorStat
+	pendingRemediatorStatsLock sync.Mutex
+
+	// pendingHistoryStarts and pendingHistoryCompletions collect per NHC the
+	// remediation history entries started and completed since the last status patch
+	pendingHistoryStarts      map[string][]remediationv1alpha1.RemediationHistoryEntry
+	pendingHistoryCompletions map[string][]remediationv1alpha1.RemediationHistoryEntry
+	pendingHistoryLock        sync.Mutex
+
+	// reconcileSummaries collects per NHC the per-node events of the running
+	// reconcile, for NHCs which opted into summary events only; lastSummaryEvents
+	// remembers the last emitted summary per NHC, so unchanged state emits nothing
+	reconcileSummaries     map[string]*reconcileSummary
+	lastSummaryEvents      map[string]string
+	reconcileSummariesLock sync.Mutex
+
+	// templateWatchController is the controller built in SetupWithManager, kept
+	// for adding watches on remediation template kinds discovered at runtime
+	templateWatchController controller.Controller
+
+	// watchedTemplateGVKs tracks the template GVKs a watch was already added for
+	watchedTemplateGVKs     map[schema.GroupVersionKind]bool
+	watchedTemplateGVKsLock sync.Mutex
 }
 
-// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=core,resources=pods/eviction,verbs=create
+// +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=remediation.medik8s.io,resources=nodehealthchecks,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=remediation.medik8s.io,resources=nodehealthchecks/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=remediation.medik8s.io,resources=nodehealthchecks/finalizers,verbs=update
 // +kubebuilder:rbac:groups=config.openshift.io,resources=clusterversions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=machine.openshift.io,resources=machinehealthchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=machine.openshift.io,resources=machines,verbs=get;list;watch
+// +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
 func (r *NodeHealthCheckReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithValues("NodeHealthCheck", req.NamespacedName)
 
+	// coalesce rapid repeated enqueues of the same NHC, e.g. caused by mass node
+	// condition flapping. The deferred reconcile will see the final state.
+	if delay := r.debounceReconcile(req.Name); delay > 0 {
+		log.V(5).Info("deferring reconcile, this NHC was just reconciled", "delay", delay)
+		return ctrl.Result{RequeueAfter: delay}, nil
+	}
+
 	// fetch nhc
 	nhc := &remediationv1alpha1.NodeHealthCheck{}
 	err := r.Get(ctx, req.NamespacedName, nhc)
@@ -90,11 +370,58 @@ func (r *NodeHealthCheckReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	if err != nil {
 		log.Error(err, "failed fetching Node Health Check", "object", nhc)
 		if apierrors.IsNotFound(err) {
+			metrics.DeleteNodeHealthCheckSelectedNodes(req.Name)
+			metrics.DeleteNodeHealthCheckRemediationSuccessRatio(req.Name)
 			return result, nil
 		}
 		return result, err
 	}
 
+	// with protected in-flight remediations, a finalizer on the NHC lets us
+	// release the remediation CRs' finalizers before the NHC goes away
+	if nhc.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(nhc, protectInFlightFinalizer) {
+			if err := r.releaseProtectedRemediations(ctx, nhc); err != nil {
+				return result, err
+			}
+			controllerutil.RemoveFinalizer(nhc, protectInFlightFinalizer)
+			if err := r.Update(ctx, nhc); err != nil {
+				return result, err
+			}
+		}
+		// stop reconciling, the NHC is being deleted
+		metrics.DeleteNodeHealthCheckSelectedNodes(nhc.GetName())
+		metrics.DeleteNodeHealthCheckRemediationSuccessRatio(nhc.GetName())
+		return result, nil
+	}
+	if nhc.Spec.ProtectInFlightRemediations && !controllerutil.ContainsFinalizer(nhc, protectInFlightFinalizer) {
+		controllerutil.AddFinalizer(nhc, protectInFlightFinalizer)
+		if err := r.Update(ctx, nhc); err != nil {
+			return result, err
+		}
+	}
+
+	// overlay inherited settings from the parent NHC chain, in memory only
+	if err := r.resolveInheritance(ctx, nhc); err != nil {
+		log.Error(err, "failed to resolve the NHC's inheritance")
+		return result, err
+	}
+
+	// watch the referenced template kinds, so template fixes are noticed promptly
+	r.ensureTemplateWatches(nhc)
+
+	// a spec change may strand in-flight remediation CRs created from templates
+	// the new spec no longer references; clean them up before remediating, so
+	// still-unhealthy nodes restart with the new spec's remediation chain
+	if nhc.Status.ObservedGeneration > 0 && nhc.Status.ObservedGeneration < nhc.Generation {
+		log.Info("spec changed since the last completed reconcile, re-evaluating the in-flight remediations",
+			"observedGeneration", nhc.Status.ObservedGeneration, "generation", nhc.Generation)
+		if err := r.cleanupStaleRemediationCRs(ctx, nhc); err != nil {
+			log.Error(err, "failed to clean up remediation CRs no longer matching the spec")
+			return result, err
+		}
+	}
+
 	// check if we need to disable NHC because of existimg MHCs
 	if disable := r.MHCChecker.NeedDisableNHC(); disable {
 		// update status if needed
@@ -107,241 +434,3539 @@ func (r *NodeHealthCheckReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 				Message: "Custom MachineHealthCheck(s) detected, disabling NHC to avoid conflicts",
 			})
 			r.Recorder.Eventf(nhc, eventTypeWarning, eventReasonDisabled, "Custom MachineHealthCheck(s) detected, disabling NHC to avoid conflicts")
-			err = r.Client.Status().Update(context.Background(), nhc)
+			err = r.updateStatusConditions(ctx, nhc)
+			if err != nil {
+				log.Error(err, "failed to update NHC status conditions")
+				return result, err
+			}
+		}
+		// stop reconciling, but re-check periodically so the NHC re-enables
+		// promptly once the conflicting MHCs are gone
+		if r.MHCDisabledRequeueInterval > 0 {
+			updateResultNextReconcile(&result, r.MHCDisabledRequeueInterval)
+		}
+		return result, nil
+	}
+	if condition := meta.FindStatusCondition(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled); condition != nil && condition.Status == metav1.ConditionTrue {
+		switch condition.Reason {
+		case remediationv1alpha1.ConditionReasonDisabledMHC:
+			log.Info("re-enabling NHC, no conflicting MHC configured in the cluster")
+			meta.RemoveStatusCondition(&nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled)
+			r.Recorder.Eventf(nhc, eventTypeNormal, eventReasonEnabled, "Custom MachineHealthCheck(s) removed, re-enabling NHC")
+			err = r.updateStatusConditions(ctx, nhc)
 			if err != nil {
 				log.Error(err, "failed to update NHC status conditions")
 				return result, err
 			}
+		case remediationv1alpha1.ConditionReasonDisabledTargetNodesRemediated:
+			// a completed target node campaign keeps the NHC disabled until the list changes
+			if !targetNodesRemaining(nhc) {
+				// stop reconciling
+				return result, nil
+			}
+			log.Info("re-enabling NHC, the target node list has unremediated nodes again")
+			meta.RemoveStatusCondition(&nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled)
+			r.Recorder.Eventf(nhc, eventTypeNormal, eventReasonEnabled, "Target node list changed, re-enabling NHC")
+			if err = r.updateStatusConditions(ctx, nhc); err != nil {
+				log.Error(err, "failed to update NHC status conditions")
+				return result, err
+			}
+		}
+	}
+
+	// check for the cluster-wide emergency stop, which halts all remediation instantly
+	if r.isEmergencyStopActive(ctx) {
+		if !meta.IsStatusConditionTrue(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeEmergencyStopped) {
+			log.Info("emergency stop is active, halting all remediation")
+			meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+				Type:    remediationv1alpha1.ConditionTypeEmergencyStopped,
+				Status:  metav1.ConditionTrue,
+				Reason:  remediationv1alpha1.ConditionReasonEmergencyStopConfigMap,
+				Message: fmt.Sprintf("Emergency stop ConfigMap %q found, all remediation is halted", emergencyStopConfigMapName),
+			})
+			r.Recorder.Eventf(nhc, eventTypeWarning, eventReasonSkippedEmergencyStop, "Emergency stop is active, all remediation is halted")
+			if err = r.updateStatusConditions(ctx, nhc); err != nil {
+				log.Error(err, "failed to update NHC status conditions")
+				return result, err
+			}
 		}
 		// stop reconciling
 		return result, nil
 	}
-	if meta.IsStatusConditionTrue(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled) {
-		log.Info("re-enabling NHC, no conflicting MHC configured in the cluster")
-		meta.RemoveStatusCondition(&nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled)
-		r.Recorder.Eventf(nhc, eventTypeNormal, eventReasonEnabled, "Custom MachineHealthCheck(s) removed, re-enabling NHC")
-		err = r.Client.Status().Update(context.Background(), nhc)
-		if err != nil {
+	if meta.IsStatusConditionTrue(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeEmergencyStopped) {
+		log.Info("emergency stop was lifted, resuming remediation")
+		meta.RemoveStatusCondition(&nhc.Status.Conditions, remediationv1alpha1.ConditionTypeEmergencyStopped)
+		r.Recorder.Eventf(nhc, eventTypeNormal, eventReasonEnabled, "Emergency stop was lifted, resuming remediation")
+		if err = r.updateStatusConditions(ctx, nhc); err != nil {
 			log.Error(err, "failed to update NHC status conditions")
 			return result, err
 		}
 	}
 
 	// select nodes using the nhc.selector
-	nodes, err := r.fetchNodes(ctx, nhc.Spec.Selector)
+	nodes, err := r.fetchNodes(ctx, nhc)
+	r.noteAPIResult(err)
 	if err != nil {
 		return result, err
 	}
 
+	// report the selector match counts, the denominator behind all ratio based decisions
+	controlPlaneNodes := 0
+	for i := range nodes {
+		if utils.IsControlPlane(nodes[i].GetLabels()) {
+			controlPlaneNodes++
+		}
+	}
+	metrics.SetNodeHealthCheckSelectedNodes(nhc.GetName(), controlPlaneNodes, len(nodes)-controlPlaneNodes)
+
+	// clusters managing control-plane health separately can take control-plane
+	// nodes out of the candidate set entirely, regardless of the selector; the
+	// excluded nodes are surfaced in the status as intentionally untouched
+	var excludedControlPlaneNodes []string
+	if nhc.Spec.ExcludeControlPlane {
+		candidates := nodes[:0]
+		for i := range nodes {
+			if utils.IsControlPlane(nodes[i].GetLabels()) {
+				excludedControlPlaneNodes = append(excludedControlPlaneNodes, nodes[i].GetName())
+				continue
+			}
+			candidates = append(candidates, nodes[i])
+		}
+		nodes = candidates
+		sort.Strings(excludedControlPlaneNodes)
+	}
+
+	// refuse to operate on clusters too small to lose a node safely
+	if condition := meta.FindStatusCondition(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled); condition != nil &&
+		condition.Status == metav1.ConditionTrue && condition.Reason == remediationv1alpha1.ConditionReasonDisabledClusterTooSmall &&
+		len(nodes) >= nhc.Spec.MinClusterSize {
+		log.Info("re-enabling NHC, the number of observed nodes reached the minimum cluster size again")
+		meta.RemoveStatusCondition(&nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled)
+		r.Recorder.Eventf(nhc, eventTypeNormal, eventReasonEnabled, "Observed nodes reached the minimum cluster size again, re-enabling NHC")
+		if err = r.updateStatusConditions(ctx, nhc); err != nil {
+			log.Error(err, "failed to update NHC status conditions")
+			return result, err
+		}
+	}
+	if nhc.Spec.MinClusterSize > 0 && len(nodes) < nhc.Spec.MinClusterSize {
+		if !meta.IsStatusConditionTrue(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled) {
+			log.Info("disabling NHC, the cluster is too small to lose a node safely",
+				"observedNodes", len(nodes), "minClusterSize", nhc.Spec.MinClusterSize)
+			meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+				Type:    remediationv1alpha1.ConditionTypeDisabled,
+				Status:  metav1.ConditionTrue,
+				Reason:  remediationv1alpha1.ConditionReasonDisabledClusterTooSmall,
+				Message: fmt.Sprintf("Only %d nodes observed, which is below the minimum cluster size of %d, disabling NHC", len(nodes), nhc.Spec.MinClusterSize),
+			})
+			r.Recorder.Eventf(nhc, eventTypeWarning, eventReasonDisabled,
+				"Only %d nodes observed, which is below the minimum cluster size of %d, disabling NHC", len(nodes), nhc.Spec.MinClusterSize)
+			if err = r.updateStatusConditions(ctx, nhc); err != nil {
+				log.Error(err, "failed to update NHC status conditions")
+				return result, err
+			}
+		}
+		// stop reconciling
+		return result, nil
+	}
+
 	// check nodes health
 	unhealthyNodes, err := r.checkNodesHealth(nodes, nhc)
 	if err != nil {
 		return result, err
 	}
 
-	minHealthy, err := intstr.GetScaledValueFromIntOrPercent(nhc.Spec.MinHealthy, len(nodes), true)
+	// an NHC selecting only control-plane nodes while all of them are unhealthy
+	// cannot remediate any node without breaking quorum; surface the deadlock
+	// instead of silently doing nothing
+	if err = r.updateQuorumBlockedCondition(ctx, nhc, nodes, unhealthyNodes); err != nil {
+		log.Error(err, "failed to update NHC status conditions")
+		return result, err
+	}
+
+	minHealthySpec, nextScheduleBoundary := effectiveMinHealthy(nhc, time.Now().UTC())
+	if nextScheduleBoundary != nil {
+		// requeue at the schedule boundary so the effective threshold updates promptly
+		updateResultNextReconcile(&result, *nextScheduleBoundary)
+	}
+	minHealthy, err := intstr.GetScaledValueFromIntOrPercent(minHealthySpec, len(nodes), true)
 	if err != nil {
 		log.Error(err, "failed to calculate min healthy allowed nodes",
-			"minHealthy", nhc.Spec.MinHealthy, "observedNodes", nhc.Status.ObservedNodes)
+			"minHealthy", minHealthySpec, "observedNodes", nhc.Status.ObservedNodes)
 		return result, err
 	}
 
+	var failedRemediationNodes []string
+	var machineDeletionNodes []string
 	if r.shouldTryRemediation(nhc, nodes, unhealthyNodes, minHealthy, &result) {
+		// remediate the worst nodes first: under the concurrency cap and the
+		// anti-affinity constraints, nodes which have been unhealthy the longest
+		// take priority over recent failures. Nodes unhealthy through signals
+		// without a transition time, e.g. annotations or probes, come last.
+		sort.SliceStable(unhealthyNodes, func(i, j int) bool {
+			iSince := r.unhealthyTransition(nhc, &unhealthyNodes[i])
+			jSince := r.unhealthyTransition(nhc, &unhealthyNodes[j])
+			if (iSince == nil) != (jSince == nil) {
+				return jSince == nil
+			}
+			return iSince != nil && iSince.Before(jSince)
+		})
+		// enforce the per-NHC concurrency cap, counting remediations already in flight
+		capped := nhc.Spec.MaxConcurrentRemediations != nil
+		antiAffinity := nhc.Spec.AntiAffinityLabel != ""
+		maxConcurrent := 0
+		inFlight := map[string]metav1.Time{}
+		if capped {
+			if maxConcurrent, err = intstr.GetScaledValueFromIntOrPercent(nhc.Spec.MaxConcurrentRemediations, len(nodes), true); err != nil {
+				log.Error(err, "failed to calculate max concurrent remediations",
+					"maxConcurrentRemediations", nhc.Spec.MaxConcurrentRemediations, "observedNodes", len(nodes))
+				return result, err
+			}
+		}
+		canary := nhc.Spec.CanaryRemediation && len(unhealthyNodes) > 1
+		if capped || antiAffinity || canary {
+			if inFlight, _, err = r.getInflightRemediations(nhc, nodes); err != nil {
+				return result, errors.Wrapf(err, "failed fetching remediation objects of the NHC")
+			}
+		}
+		// nodes already being remediated keep their anti-affinity domain busy
+		busyDomains := map[string]bool{}
+		if antiAffinity {
+			for i := range nodes {
+				if _, exists := inFlight[nodes[i].GetName()]; exists {
+					if domain := nodes[i].Labels[nhc.Spec.AntiAffinityLabel]; domain != "" {
+						busyDomains[domain] = true
+					}
+				}
+			}
+		}
+		// with canary remediation, the first node of a wave goes alone and the
+		// rest wait for its recovery
+		canaryNode := ""
+		if canary {
+			if canaryNode, err = r.evaluateCanary(ctx, nhc, unhealthyNodes, inFlight); err != nil {
+				return result, err
+			}
+		}
+		started := len(inFlight)
 		for i := range unhealthyNodes {
-			nextReconcile, err := r.remediate(ctx, &unhealthyNodes[i], nhc)
+			// during a canary wave only the canary node is remediated
+			if canaryNode != "" && unhealthyNodes[i].GetName() != canaryNode {
+				r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedCanary, "canary in progress",
+					fmt.Sprintf("Deferred remediation of node %s until the canary node %s recovers", unhealthyNodes[i].GetName(), canaryNode))
+				updateResultNextReconcile(&result, deferredRequeueInterval(nhc))
+				continue
+			}
+			// a machine which is already being deleted makes remediation redundant
+			// and potentially conflicting; wait for the deletion to finish instead
+			if deleting, err := r.machineBeingDeleted(ctx, &unhealthyNodes[i], nhc); err != nil {
+				return ctrl.Result{}, err
+			} else if deleting {
+				r.Log.Info("skipping remediation, the node's machine is already being deleted", "nodeName", unhealthyNodes[i].GetName())
+				r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedMachineDeletion, "machine being deleted",
+					fmt.Sprintf("Skipped remediation of node %s, its machine is already being deleted", unhealthyNodes[i].GetName()))
+				machineDeletionNodes = append(machineDeletionNodes, unhealthyNodes[i].GetName())
+				updateResultNextReconcile(&result, deferredRequeueInterval(nhc))
+				continue
+			}
+			// the node hosting the active operator instance is not remediated
+			// while it holds that role, so the operator doesn't contribute to a
+			// reboot of its own host mid-decision
+			if nhc.Spec.ProtectOperatorNode {
+				if operatorNode := utils.GetOperatorNodeName(); operatorNode != "" && operatorNode == unhealthyNodes[i].GetName() {
+					r.Log.Info("skipping remediation, the node hosts the active operator instance", "nodeName", unhealthyNodes[i].GetName())
+					r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedOperatorNode, "operator node",
+						fmt.Sprintf("Skipped remediation of node %s, it hosts the active operator instance", unhealthyNodes[i].GetName()))
+					updateResultNextReconcile(&result, deferredRequeueInterval(nhc))
+					continue
+				}
+			}
+			// allow at most one concurrent remediation per anti-affinity domain,
+			// so correlated nodes aren't taken down together
+			if antiAffinity {
+				if domain := unhealthyNodes[i].Labels[nhc.Spec.AntiAffinityLabel]; domain != "" {
+					if _, exists := inFlight[unhealthyNodes[i].GetName()]; !exists {
+						if busyDomains[domain] {
+							r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedAntiAffinity, "anti-affinity",
+								fmt.Sprintf("Deferred remediation of node %s, another node with %s=%q is already being remediated",
+									unhealthyNodes[i].GetName(), nhc.Spec.AntiAffinityLabel, domain))
+							updateResultNextReconcile(&result, deferredRequeueInterval(nhc))
+							continue
+						}
+						busyDomains[domain] = true
+					}
+				}
+			}
+			if capped {
+				if _, exists := inFlight[unhealthyNodes[i].GetName()]; !exists {
+					if started >= maxConcurrent {
+						r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedConcurrencyCap, "concurrency cap",
+							fmt.Sprintf("Deferred remediation of node %s, the maximum number of concurrent remediations is reached", unhealthyNodes[i].GetName()))
+						updateResultNextReconcile(&result, deferredRequeueInterval(nhc))
+						continue
+					}
+					started++
+				}
+			}
+			nextReconcile, failed, err := r.remediate(ctx, &unhealthyNodes[i], nhc)
 			if err != nil {
 				return ctrl.Result{}, err
 			}
+			if failed {
+				failedRemediationNodes = append(failedRemediationNodes, unhealthyNodes[i].GetName())
+			}
 			if nextReconcile != nil {
 				updateResultNextReconcile(&result, *nextReconcile)
 			}
 		}
 	}
 
-	inFlightRemediations, err := r.getInflightRemediations(nhc)
+	inFlightRemediations, templatesInUse, err := r.getInflightRemediations(nhc, nodes)
 	if err != nil {
 		return ctrl.Result{}, errors.Wrapf(err, "failed fetching remediation objects of the NHC")
 	}
 
-	err = r.patchStatus(nhc, len(nodes), len(unhealthyNodes), inFlightRemediations)
+	// release leases left behind by a crash between lease and CR creation
+	if err = r.cleanupOrphanedLeases(ctx, nhc, unhealthyNodes, inFlightRemediations); err != nil {
+		log.Error(err, "failed to clean up orphaned remediation leases")
+		return ctrl.Result{}, err
+	}
+
+	if nhc.Spec.MirrorStatusToNodes && nhc.Spec.NodeResourceRef == nil {
+		if err = r.mirrorStatusToNodes(ctx, nodes, inFlightRemediations); err != nil {
+			log.Error(err, "failed to mirror remediation state to nodes")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// conditions crossing their duration threshold don't necessarily come with
+	// a node event, so NHCs with durations shorter than the cache resync are
+	// re-checked on the duration's cadence instead of the resync's
+	if r.PollShortConditionDurations {
+		if shortest := shortestConditionDuration(nhc); shortest > 0 && shortest < cacheResyncPeriod {
+			updateResultNextReconcile(&result, shortest)
+		}
+	}
+
+	// probe results arrive from the background workers without triggering node
+	// events, so NHCs with an HTTP probe poll on a short fixed cadence
+	if nhc.Spec.HTTPProbe != nil {
+		updateResultNextReconcile(&result, probePollInterval)
+	}
+
+	// nodes merely staying healthy don't trigger events, so poll while any node
+	// is within the post remediation verify period
+	nodesUnderVerification := r.nodesUnderVerification(nhc)
+	if len(nodesUnderVerification) > 0 {
+		updateResultNextReconcile(&result, 1*time.Minute)
+	}
+
+	r.emitSummaryEvent(nhc, len(nodes), len(unhealthyNodes))
+
+	err = r.patchStatus(nhc, len(nodes), unhealthyNodes, inFlightRemediations, templatesInUse, failedRemediationNodes, machineDeletionNodes, nodesUnderVerification, excludedControlPlaneNodes)
 	if err != nil {
 		log.Error(err, "failed to patch NHC status")
 		return ctrl.Result{}, err
 	}
+	r.applyResyncJitter(&result)
 	return result, nil
 }
 
-func (r *NodeHealthCheckReconciler) shouldTryRemediation(
-	nhc *remediationv1alpha1.NodeHealthCheck, nodes []v1.Node, unhealthyNodes []v1.Node, minHealthy int, result *ctrl.Result) bool {
+// applyResyncJitter adds a random offset to the computed requeue interval, so
+// the self-scheduled reconciles of many NHCs spread out over time instead of
+// hitting the API at the same cadence. Event-driven reconciles are unaffected,
+// they don't go through the requeue interval.
+func (r *NodeHealthCheckReconciler) applyResyncJitter(result *ctrl.Result) {
+	if r.ResyncJitter <= 0 || result.RequeueAfter <= 0 {
+		return
+	}
+	result.RequeueAfter += time.Duration(rand.Int63n(int64(r.ResyncJitter)))
+}
 
-	if len(unhealthyNodes) == 0 {
-		return false
+// effectiveMinHealthy returns the MinHealthy to use at the given time, considering the
+// scheduled overrides, and the duration until the next schedule boundary, if any.
+func effectiveMinHealthy(nhc *remediationv1alpha1.NodeHealthCheck, now time.Time) (*intstr.IntOrString, *time.Duration) {
+	if len(nhc.Spec.ScheduledMinHealthy) == 0 {
+		return nhc.Spec.MinHealthy, nil
 	}
 
-	healthyNodes := len(nodes) - len(unhealthyNodes)
-	if healthyNodes >= minHealthy {
-		if len(nhc.Spec.PauseRequests) > 0 {
-			// some actors want to pause remediation.
-			msg := "Skipping remediation because there are pause requests"
-			r.Log.Info(msg)
-			r.Recorder.Event(nhc, eventTypeNormal, eventReasonRemediationSkipped, msg)
-			return false
+	const minutesPerDay = 24 * 60
+	nowMinute := now.Hour()*60 + now.Minute()
+
+	minHealthy := nhc.Spec.MinHealthy
+	matched := false
+	nextBoundary := minutesPerDay
+	for _, schedule := range nhc.Spec.ScheduledMinHealthy {
+		start, err := minuteOfDay(schedule.Start)
+		if err != nil {
+			continue
 		}
-		if r.isClusterUpgrading() {
-			updateResultNextReconcile(result, 1*time.Minute)
-			r.Recorder.Event(nhc, eventTypeNormal, eventReasonRemediationSkipped, "Skipped remediation because the cluster is upgrading")
-			return false
+		end, err := minuteOfDay(schedule.End)
+		if err != nil {
+			continue
+		}
+		active := false
+		if start <= end {
+			active = nowMinute >= start && nowMinute < end
+		} else {
+			// window wraps around midnight
+			active = nowMinute >= start || nowMinute < end
+		}
+		if active && !matched {
+			minHealthy = schedule.MinHealthy
+			matched = true
+		}
+		for _, boundary := range []int{start, end} {
+			if until := (boundary - nowMinute + minutesPerDay) % minutesPerDay; until > 0 && until < nextBoundary {
+				nextBoundary = until
+			}
 		}
-		return true
 	}
-	msg := fmt.Sprintf("Skipped remediation because the number of healthy nodes selected by the selector is %d and should equal or exceed %d", healthyNodes, minHealthy)
-	r.Log.Info(msg,
-		"healthyNodes", healthyNodes, "minHealthy", minHealthy)
-	r.Recorder.Event(nhc, eventTypeWarning, eventReasonRemediationSkipped, msg)
-	return false
+
+	// requeue one minute past the boundary, so the reconcile sees the new window
+	untilBoundary := time.Duration(nextBoundary)*time.Minute + time.Minute
+	return minHealthy, &untilBoundary
 }
 
-func (r *NodeHealthCheckReconciler) isClusterUpgrading() bool {
-	clusterUpgrading, err := r.ClusterUpgradeStatusChecker.Check()
+// minuteOfDay parses a 24-hour "HH:MM" string into the minute of the day.
+func minuteOfDay(value string) (int, error) {
+	parsed, err := time.Parse("15:04", value)
 	if err != nil {
-		// log the error but don't return - if we can't reliably tell if
-		// the cluster is upgrading then just continue with remediation.
-		// TODO finer error handling may help to decide otherwise here.
-		r.Log.Error(err, "failed to check if the cluster is upgrading. Proceed with remediation as if it is not upgrading")
-	}
-	if clusterUpgrading {
-		r.Log.Info("Skipping remediation because the cluster is currently upgrading.")
-		return true
+		return 0, err
 	}
-	return false
+	return parsed.Hour()*60 + parsed.Minute(), nil
 }
 
-func (r *NodeHealthCheckReconciler) fetchNodes(ctx context.Context, labelSelector metav1.LabelSelector) ([]v1.Node, error) {
-	var nodes v1.NodeList
-	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+// isEmergencyStopActive checks if the well-known emergency stop ConfigMap exists in the
+// operator's namespace. While it exists no remediation is started cluster-wide.
+func (r *NodeHealthCheckReconciler) isEmergencyStopActive(ctx context.Context) bool {
+	ns, err := utils.GetDeploymentNamespace()
 	if err != nil {
-		err = errors.Wrapf(err, "failed converting a selector from NHC selector")
-		return []v1.Node{}, err
+		// without a known namespace there is no emergency stop to look for
+		return false
 	}
-	err = r.List(
-		ctx,
-		&nodes,
-		&client.ListOptions{LabelSelector: selector},
-	)
-	return nodes.Items, err
+	cm := &v1.ConfigMap{}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: ns, Name: emergencyStopConfigMapName}, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			r.Log.Error(err, "failed to check for the emergency stop ConfigMap, proceeding as if it doesn't exist")
+		}
+		return false
+	}
+	return true
 }
 
-func (r *NodeHealthCheckReconciler) checkNodesHealth(nodes []v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) ([]v1.Node, error) {
-	var unhealthy []v1.Node
-	for i := range nodes {
-		n := &nodes[i]
-		if isHealthy(nhc.Spec.UnhealthyConditions, n.Status.Conditions) {
-			err := r.markHealthy(n, nhc)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			// ignore nodes handled by MHC
-			if r.MHCChecker.NeedIgnoreNode(n) {
-				continue
-			}
-			unhealthy = append(unhealthy, *n)
+// debounceReconcile returns how long a reconcile of the given NHC should be deferred,
+// or zero when it may run now. Deferred reconciles are requeued, not dropped, so the
+// final state is always reconciled.
+func (r *NodeHealthCheckReconciler) debounceReconcile(name string) time.Duration {
+	r.lastReconcilesLock.Lock()
+	defer r.lastReconcilesLock.Unlock()
+	if r.lastReconciles == nil {
+		r.lastReconciles = make(map[string]time.Time)
+	}
+	interval := r.minReconcileInterval()
+	now := time.Now()
+	if last, exists := r.lastReconciles[name]; exists {
+		if elapsed := now.Sub(last); elapsed < interval {
+			return interval - elapsed
 		}
 	}
-	return unhealthy, nil
+	r.lastReconciles[name] = now
+	return 0
 }
 
-func (r *NodeHealthCheckReconciler) markHealthy(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) error {
-	cr, err := r.generateRemediationCR(n, nhc)
-	if err != nil {
-		return err
+// minReconcileInterval returns the configured minimum time between reconciles
+// of the same NHC, defaulting to the built-in debounce interval.
+func (r *NodeHealthCheckReconciler) minReconcileInterval() time.Duration {
+	if r.MinReconcileInterval > 0 {
+		return r.MinReconcileInterval
 	}
+	return reconcileDebounceInterval
+}
 
-	// check if CR is deleted already
-	err = r.Client.Get(context.Background(), client.ObjectKeyFromObject(cr), cr)
-	if err != nil && !apierrors.IsNotFound(err) {
-		return err
-	} else if apierrors.IsNotFound(err) || cr.GetDeletionTimestamp() != nil {
-		return nil
+// noteAPIResult tracks the operator's own API connectivity based on the outcome
+// of the node list call, for the network isolation cooldown.
+func (r *NodeHealthCheckReconciler) noteAPIResult(err error) {
+	r.apiHealthLock.Lock()
+	defer r.apiHealthLock.Unlock()
+	if err != nil {
+		r.apiErrorTime = time.Now()
+		return
 	}
+	if !r.apiErrorTime.IsZero() {
+		r.apiReconnectTime = time.Now()
+		r.apiErrorTime = time.Time{}
+	}
+}
 
-	r.Log.V(5).Info("node seems healthy", "Node name", n.Name)
-
-	err = r.Client.Delete(context.Background(), cr, &client.DeleteOptions{})
-	// if the node is already healthy then there is no remediation object for it
-	if err != nil && !apierrors.IsNotFound(err) {
-		return err
+// suspectedNetworkIsolation reports whether remediation should be halted because
+// the observed unhealthiness looks like a network partition: either the operator
+// itself just regained API connectivity, or enough nodes turned unhealthy nearly
+// simultaneously. The returned reason is meant for events and logs.
+func (r *NodeHealthCheckReconciler) suspectedNetworkIsolation(nhc *remediationv1alpha1.NodeHealthCheck, nodes []v1.Node, unhealthyNodes []v1.Node) (bool, string) {
+	safeguard := nhc.Spec.NetworkIsolationSafeguard
+	if safeguard == nil {
+		return false, ""
 	}
 
-	if err == nil {
-		// deleted an actual object
-		r.Log.Info("deleted node external remediation object", "Node name", n.Name)
-		r.Recorder.Eventf(nhc, eventTypeNormal, eventReasonRemediationRemoved, "Deleted remediation object for node %s", n.Name)
+	cooldown := 2 * time.Minute
+	if safeguard.APIConnectivityCooldown != nil {
+		cooldown = safeguard.APIConnectivityCooldown.Duration
+	}
+	r.apiHealthLock.Lock()
+	reconnect := r.apiReconnectTime
+	r.apiHealthLock.Unlock()
+	if !reconnect.IsZero() && time.Since(reconnect) < cooldown {
+		return true, fmt.Sprintf("the operator regained API connectivity %s ago, waiting out the %s cooldown", time.Since(reconnect).Round(time.Second), cooldown)
 	}
-	return nil
-}
 
-func isHealthy(conditionTests []remediationv1alpha1.UnhealthyCondition, nodeConditions []v1.NodeCondition) bool {
-	now := time.Now()
-	nodeConditionByType := make(map[v1.NodeConditionType]v1.NodeCondition)
-	for _, nc := range nodeConditions {
-		nodeConditionByType[nc.Type] = nc
+	abortAt, err := intstr.GetScaledValueFromIntOrPercent(&safeguard.UnhealthyRatioAbort, len(nodes), true)
+	if err != nil || abortAt <= 0 {
+		return false, ""
+	}
+	window := time.Minute
+	if safeguard.CorrelationWindow != nil {
+		window = safeguard.CorrelationWindow.Duration
 	}
 
-	for _, c := range conditionTests {
-		n, exists := nodeConditionByType[c.Type]
-		if !exists {
-			continue
+	var transitions []time.Time
+	for i := range unhealthyNodes {
+		if since := r.unhealthyTransition(nhc, &unhealthyNodes[i]); since != nil {
+			transitions = append(transitions, since.Time)
 		}
-		if n.Status == c.Status && now.After(n.LastTransitionTime.Add(c.Duration.Duration)) {
-			return false
+	}
+	if len(transitions) < abortAt {
+		return false, ""
+	}
+	sort.Slice(transitions, func(i, j int) bool { return transitions[i].Before(transitions[j]) })
+	for i := 0; i+abortAt <= len(transitions); i++ {
+		if transitions[i+abortAt-1].Sub(transitions[i]) <= window {
+			return true, fmt.Sprintf("%d nodes turned unhealthy within %s of each other", abortAt, window)
 		}
 	}
-	return true
+	return false, ""
 }
 
-// SetupWithManager sets up the controller with the Manager.
-func (r *NodeHealthCheckReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&remediationv1alpha1.NodeHealthCheck{}).
-		Watches(&source.Kind{Type: &v1.Node{}}, handler.EnqueueRequestsFromMapFunc(utils.NHCByNodeMapperFunc(mgr.GetClient(), mgr.GetLogger()))).
-		Complete(r)
-}
+func (r *NodeHealthCheckReconciler) shouldTryRemediation(
+	nhc *remediationv1alpha1.NodeHealthCheck, nodes []v1.Node, unhealthyNodes []v1.Node, minHealthy int, result *ctrl.Result) bool {
 
-func (r *NodeHealthCheckReconciler) remediate(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*time.Duration, error) {
-	cr, err := r.generateRemediationCR(n, nhc)
-	if err != nil {
-		return nil, err
+	if len(unhealthyNodes) == 0 {
+		return false
 	}
 
-	// check if CR already exists
-	if err = r.Client.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+	if suspected, reason := r.suspectedNetworkIsolation(nhc, nodes, unhealthyNodes); suspected {
+		msg := fmt.Sprintf("Skipping remediation because a network partition is suspected: %s", reason)
+		r.Log.Info(msg)
+		r.Recorder.Event(nhc, eventTypeWarning, eventReasonSkippedNetworkIsolation, msg)
+		updateResultNextReconcile(result, deferredRequeueInterval(nhc))
+		return false
+	}
+
+	if met, reason := r.prerequisiteHealthGateMet(nhc); !met {
+		msg := fmt.Sprintf("Skipping remediation because the prerequisite health gate is not met: %s", reason)
+		r.Log.Info(msg)
+		r.Recorder.Event(nhc, eventTypeNormal, eventReasonSkippedHealthGate, msg)
+		updateResultNextReconcile(result, deferredRequeueInterval(nhc))
+		return false
+	}
+
+	healthyNodes := len(nodes) - len(unhealthyNodes)
+	if expected := nhc.Spec.ExpectedNodes; expected != nil {
+		// compare against the declared cluster size instead of a percentage of the
+		// observed nodes, which is misleading when nodes vanished from the API
+		minHealthy = *expected
+		if nhc.Spec.ExpectedNodesTolerance != nil {
+			minHealthy -= *nhc.Spec.ExpectedNodesTolerance
+		}
+	}
+	paused := len(nhc.Spec.PauseRequests) > 0
+	// guardrail against forgotten pause requests
+	pauseExpired := paused && r.MaxPauseDuration > 0 && nhc.Status.PausedSince != nil &&
+		time.Since(nhc.Status.PausedSince.Time) > r.MaxPauseDuration
+	if pauseExpired {
+		paused = false
+	}
+	// remediations declared non-disruptive don't reduce availability, so the
+	// MinHealthy gate doesn't apply to them
+	nonDisruptive := remediationNonDisruptive(nhc)
+
+	remediate, reason := resources.ShouldRemediate(len(nodes), len(unhealthyNodes), minHealthy,
+		nhc.Spec.AllowRemediationWhenAllUnhealthy, nonDisruptive, paused, r.isClusterUpgrading())
+	switch reason {
+	case resources.ReasonPaused:
+		// some actors want to pause remediation.
+		msg := "Skipping remediation because there are pause requests"
+		r.Log.Info(msg)
+		r.Recorder.Event(nhc, eventTypeNormal, eventReasonSkippedPaused, msg)
+	case resources.ReasonClusterUpgrading:
+		updateResultNextReconcile(result, deferredRequeueInterval(nhc))
+		r.Recorder.Event(nhc, eventTypeNormal, eventReasonSkippedUpgrading, "Skipped remediation because the cluster is upgrading")
+	case resources.ReasonAllUnhealthyOverride:
+		msg := fmt.Sprintf("All %d selected nodes are unhealthy, remediating despite the violated MinHealthy gate because allowRemediationWhenAllUnhealthy is set", len(nodes))
+		r.Log.Info(msg)
+		r.Recorder.Event(nhc, eventTypeWarning, eventReasonRemediationCreated, msg)
+	case resources.ReasonMinHealthyViolated:
+		msg := fmt.Sprintf("Skipped remediation because the number of healthy nodes selected by the selector is %d and should equal or exceed %d", healthyNodes, minHealthy)
+		r.Log.Info(msg,
+			"healthyNodes", healthyNodes, "minHealthy", minHealthy)
+		r.Recorder.Event(nhc, eventTypeWarning, eventReasonSkippedMinHealthy, msg)
+		// node events drive the re-evaluation of the MinHealthy gate; with a
+		// configured interval additionally poll, so a recovering pool unblocks
+		// remediation without waiting for the next event
+		if nhc.Spec.DeferredRequeueInterval != nil {
+			updateResultNextReconcile(result, nhc.Spec.DeferredRequeueInterval.Duration)
+		}
+	}
+	if remediate {
+		if pauseExpired {
+			msg := fmt.Sprintf("Ignoring pause requests in place for more than the maximum pause duration %s, resuming remediation", r.MaxPauseDuration)
+			r.Log.Info(msg)
+			r.Recorder.Event(nhc, eventTypeWarning, eventReasonEnabled, msg)
+		}
+		if healthyNodes < minHealthy && reason != resources.ReasonAllUnhealthyOverride {
+			r.Log.Info("proceeding below the MinHealthy gate, the remediation is declared non-disruptive",
+				"healthyNodes", healthyNodes, "minHealthy", minHealthy)
+		}
+	}
+	return remediate
+}
+
+// remediationNonDisruptive reports whether the NHC's remediation is declared
+// not to reduce availability. With escalating remediations every step must be
+// non-disruptive, otherwise an escalation could end up at a disruptive step
+// which bypassed the MinHealthy gate.
+func remediationNonDisruptive(nhc *remediationv1alpha1.NodeHealthCheck) bool {
+	if len(nhc.Spec.EscalatingRemediations) > 0 {
+		for _, escalation := range nhc.Spec.EscalatingRemediations {
+			if !escalation.NonDisruptive {
+				return false
+			}
+		}
+		return true
+	}
+	return nhc.Spec.NonDisruptiveRemediation
+}
+
+// reconcileSummary aggregates the per-node events of a single reconcile, for
+// NHCs which opted into summary events only.
+type reconcileSummary struct {
+	created int
+	removed int
+	failed  int
+	skipped map[string]int
+}
+
+// nodeEvent emits a granular per-node event, unless the NHC opted into summary
+// events only, in which case the event is folded into the aggregated summary
+// emitted at the end of the reconcile. The summaryReason is the short label the
+// skip counts are grouped by in the summary.
+func (r *NodeHealthCheckReconciler) nodeEvent(nhc *remediationv1alpha1.NodeHealthCheck, eventType, reason, summaryReason, message string) {
+	if !nhc.Spec.SummaryEventsOnly {
+		r.Recorder.Event(nhc, eventType, reason, message)
+		return
+	}
+
+	r.reconcileSummariesLock.Lock()
+	defer r.reconcileSummariesLock.Unlock()
+	if r.reconcileSummaries == nil {
+		r.reconcileSummaries = make(map[string]*reconcileSummary)
+	}
+	summary := r.reconcileSummaries[nhc.GetName()]
+	if summary == nil {
+		summary = &reconcileSummary{skipped: map[string]int{}}
+		r.reconcileSummaries[nhc.GetName()] = summary
+	}
+	switch {
+	case reason == eventReasonRemediationCreated:
+		summary.created++
+	case reason == eventReasonRemediationRemoved:
+		summary.removed++
+	case reason == eventReasonRemediationFailed:
+		summary.failed++
+	case strings.HasPrefix(reason, eventReasonRemediationSkipped):
+		summary.skipped[summaryReason]++
+	}
+}
+
+// emitSummaryEvent emits the single aggregated event of this reconcile, when
+// the NHC opted into summary events only and the summary changed since the
+// last emitted one.
+func (r *NodeHealthCheckReconciler) emitSummaryEvent(nhc *remediationv1alpha1.NodeHealthCheck, observedNodes int, unhealthyNodes int) {
+	if !nhc.Spec.SummaryEventsOnly {
+		return
+	}
+
+	r.reconcileSummariesLock.Lock()
+	defer r.reconcileSummariesLock.Unlock()
+	summary := r.reconcileSummaries[nhc.GetName()]
+	delete(r.reconcileSummaries, nhc.GetName())
+	if summary == nil {
+		summary = &reconcileSummary{}
+	}
+
+	msg := fmt.Sprintf("Observed %d nodes, %d unhealthy, created %d", observedNodes, unhealthyNodes, summary.created)
+	if summary.removed > 0 {
+		msg += fmt.Sprintf(", removed %d", summary.removed)
+	}
+	if summary.failed > 0 {
+		msg += fmt.Sprintf(", failed %d", summary.failed)
+	}
+	skipped := 0
+	var reasons []string
+	for reason, count := range summary.skipped {
+		skipped += count
+		reasons = append(reasons, fmt.Sprintf("%s: %d", reason, count))
+	}
+	if skipped > 0 {
+		sort.Strings(reasons)
+		msg += fmt.Sprintf(", skipped %d (%s)", skipped, strings.Join(reasons, ", "))
+	}
+
+	if r.lastSummaryEvents == nil {
+		r.lastSummaryEvents = make(map[string]string)
+	}
+	if r.lastSummaryEvents[nhc.GetName()] == msg {
+		return
+	}
+	r.lastSummaryEvents[nhc.GetName()] = msg
+	r.Recorder.Event(nhc, eventTypeNormal, eventReasonReconcileSummary, msg)
+}
+
+func (r *NodeHealthCheckReconciler) isClusterUpgrading() bool {
+	clusterUpgrading, err := r.ClusterUpgradeStatusChecker.Check()
+	if err != nil {
+		// log the error but don't return - if we can't reliably tell if
+		// the cluster is upgrading then just continue with remediation.
+		// TODO finer error handling may help to decide otherwise here.
+		r.Log.Error(err, "failed to check if the cluster is upgrading. Proceed with remediation as if it is not upgrading")
+	}
+	if clusterUpgrading {
+		r.Log.Info("Skipping remediation because the cluster is currently upgrading.")
+		return true
+	}
+	return false
+}
+
+func (r *NodeHealthCheckReconciler) fetchNodes(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck) ([]v1.Node, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&nhc.Spec.Selector)
+	if err != nil {
+		err = errors.Wrapf(err, "failed converting a selector from NHC selector")
+		return []v1.Node{}, err
+	}
+
+	if ref := nhc.Spec.NodeResourceRef; ref != nil {
+		return r.fetchNodeResources(ctx, ref, selector)
+	}
+
+	if r.NodeListPageSize > 0 {
+		return r.fetchNodesPaged(ctx, selector)
+	}
+
+	var nodes v1.NodeList
+	err = r.List(
+		ctx,
+		&nodes,
+		&client.ListOptions{LabelSelector: selector},
+	)
+	return nodes.Items, err
+}
+
+// fetchNodesPaged lists the matching nodes in pages of the configured size,
+// keeping only the fields the health evaluation needs from each page, so no
+// more than one page of full node objects is held at a time. All pages are
+// aggregated before any health evaluation, so the minHealthy calculations see
+// the complete node set, exactly as with the single list.
+func (r *NodeHealthCheckReconciler) fetchNodesPaged(ctx context.Context, selector labels.Selector) ([]v1.Node, error) {
+	var nodes []v1.Node
+	continueToken := ""
+	for {
+		var page v1.NodeList
+		err := r.APIReader.List(
+			ctx,
+			&page,
+			&client.ListOptions{LabelSelector: selector, Limit: r.NodeListPageSize, Continue: continueToken},
+		)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Items {
+			nodes = append(nodes, trimmedNode(&page.Items[i]))
+		}
+		continueToken = page.GetContinue()
+		if continueToken == "" {
+			return nodes, nil
+		}
+	}
+}
+
+// trimmedNode keeps only the node fields the health evaluation and remediation
+// need, dropping the bulky ones like the image list and the volume lists. The
+// managed fields stay: annotationManagedTime dates the unhealthy annotation
+// durations from them. All writes to nodes fetch a fresh object first, so the
+// trimmed copies are never written back.
+func trimmedNode(node *v1.Node) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              node.Name,
+			UID:               node.UID,
+			Labels:            node.Labels,
+			Annotations:       node.Annotations,
+			CreationTimestamp: node.CreationTimestamp,
+			DeletionTimestamp: node.DeletionTimestamp,
+			ManagedFields:     node.ManagedFields,
+		},
+		Spec: v1.NodeSpec{
+			Taints:        node.Spec.Taints,
+			Unschedulable: node.Spec.Unschedulable,
+			ProviderID:    node.Spec.ProviderID,
+		},
+		Status: v1.NodeStatus{
+			Conditions:  node.Status.Conditions,
+			Addresses:   node.Status.Addresses,
+			Allocatable: node.Status.Allocatable,
+			Capacity:    node.Status.Capacity,
+			NodeInfo:    node.Status.NodeInfo,
+		},
+	}
+}
+
+// fetchNodeResources lists the node-like resource kind configured on the NHC and
+// converts each item into a Node shim, so the rest of the health checks can stay
+// agnostic of the actual kind being checked.
+func (r *NodeHealthCheckReconciler) fetchNodeResources(ctx context.Context, ref *remediationv1alpha1.NodeResourceRef, selector labels.Selector) ([]v1.Node, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{Group: ref.Group, Version: ref.Version, Kind: ref.Kind + "List"})
+	if err := r.List(ctx, list, &client.ListOptions{LabelSelector: selector}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list node resources of kind %s", ref.Kind)
+	}
+
+	nodes := make([]v1.Node, 0, len(list.Items))
+	for i := range list.Items {
+		nodes = append(nodes, nodeFromUnstructured(&list.Items[i]))
+	}
+	return nodes, nil
+}
+
+// nodeFromUnstructured converts a node-like resource into a core Node, keeping the
+// metadata and the node-style conditions found in its unstructured status.
+func nodeFromUnstructured(obj *unstructured.Unstructured) v1.Node {
+	node := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Labels:    obj.GetLabels(),
+			UID:       obj.GetUID(),
+		},
+	}
+	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if !found || err != nil {
+		return node
+	}
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		nodeCondition := v1.NodeCondition{}
+		if t, ok := condition["type"].(string); ok {
+			nodeCondition.Type = v1.NodeConditionType(t)
+		}
+		if s, ok := condition["status"].(string); ok {
+			nodeCondition.Status = v1.ConditionStatus(s)
+		}
+		if lt, ok := condition["lastTransitionTime"].(string); ok {
+			if parsed, err := time.Parse(time.RFC3339, lt); err == nil {
+				nodeCondition.LastTransitionTime = metav1.NewTime(parsed)
+			}
+		}
+		node.Status.Conditions = append(node.Status.Conditions, nodeCondition)
+	}
+	return node
+}
+
+// nodeHealthy is the composite per-node health evaluation: the profile
+// resolved unhealthy conditions with their minimum count, unhealthy
+// annotations and taints, the HTTP probe, resource availability, flap
+// detection, pod disruptions and the kubelet checks. Every path deciding
+// whether a node is healthy must go through here, so they can't diverge.
+func (r *NodeHealthCheckReconciler) nodeHealthy(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	return r.conditionsHealthy(nhc, n) && r.annotationsHealthy(nhc, n) && r.taintsHealthy(nhc, n) && r.probeHealthy(nhc, n) && r.resourcesAvailable(nhc, n) && r.conditionsNotFlapping(nhc, n) && r.podDisruptionsHealthy(nhc, n) && r.kubeletVersionCurrent(nhc, n) && r.kubeletCertValid(nhc, n)
+}
+
+func (r *NodeHealthCheckReconciler) checkNodesHealth(nodes []v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) ([]v1.Node, error) {
+	var unhealthy []v1.Node
+	for i := range nodes {
+		n := &nodes[i]
+		if r.nodeHealthy(nhc, n) {
+			err := r.markHealthy(n, nhc)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			// an unhealthy observation restarts the post remediation verification clock
+			r.clearVerifyHealthySince(n, nhc)
+			// ignore nodes handled by MHC
+			if r.MHCChecker.NeedIgnoreNode(n) {
+				continue
+			}
+			// with workload namespaces configured, the node is only a candidate while
+			// it hosts failing pods from one of them. Existing remediations are kept.
+			if len(nhc.Spec.WorkloadNamespaces) > 0 && !r.hostsFailingWorkload(nhc, n.GetName()) {
+				r.Log.Info("skipping unhealthy node, it hosts no failing pods from the workload namespaces", "nodeName", n.Name)
+				continue
+			}
+			// a target node campaign restricts remediation to the listed nodes
+			if len(nhc.Spec.TargetNodes) > 0 && !isTargetNode(nhc, n.GetName()) {
+				r.Log.Info("skipping unhealthy node, it is not in the target node list", "nodeName", n.Name)
+				continue
+			}
+			r.notifyNodeUnhealthy(nhc, n)
+			unhealthy = append(unhealthy, *n)
+		}
+	}
+	return unhealthy, nil
+}
+
+// notifyNodeUnhealthy emits a NodeUnhealthy event on the NHC once per
+// unhealthy transition of the node: subsequent reconciles observing the same
+// tripped conditions stay silent, while a different set of tripped conditions
+// counts as a new transition. The bookkeeping is cleared when the node turns
+// healthy again, so the next transition is reported.
+func (r *NodeHealthCheckReconciler) notifyNodeUnhealthy(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) {
+	var descriptions []string
+	for _, c := range matchingUnhealthyConditions(r.conditionsForNode(nhc, n), n.Status.Conditions) {
+		descriptions = append(descriptions, fmt.Sprintf("%s=%s for more than %s", c.Type, c.Status, c.Duration.Duration))
+	}
+	tripped := strings.Join(descriptions, ", ")
+
+	r.notifiedUnhealthyLock.Lock()
+	defer r.notifiedUnhealthyLock.Unlock()
+	key := fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName())
+	if last, notified := r.notifiedUnhealthy[key]; notified && last == tripped {
+		return
+	}
+	if r.notifiedUnhealthy == nil {
+		r.notifiedUnhealthy = make(map[string]string)
+	}
+	r.notifiedUnhealthy[key] = tripped
+	message := fmt.Sprintf("Node %s crossed the unhealthy threshold", n.GetName())
+	if tripped != "" {
+		message = fmt.Sprintf("%s: %s", message, tripped)
+	}
+	r.Recorder.Event(nhc, eventTypeWarning, eventReasonNodeUnhealthy, message)
+}
+
+// clearNotifiedUnhealthy forgets the node's NodeUnhealthy event bookkeeping
+// once the node is healthy, so its next unhealthy transition is reported again.
+func (r *NodeHealthCheckReconciler) clearNotifiedUnhealthy(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	r.notifiedUnhealthyLock.Lock()
+	defer r.notifiedUnhealthyLock.Unlock()
+	delete(r.notifiedUnhealthy, fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName()))
+}
+
+// conditionsForNode returns the unhealthy conditions to check the node against:
+// those of the condition profile whose selector matches the node, or the NHC's
+// base unhealthy conditions when no profile matches. Overlapping profiles are
+// resolved per the NHC's ConditionProfileResolution: the first match in list
+// order by default, or the most specific match.
+func (r *NodeHealthCheckReconciler) conditionsForNode(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) []remediationv1alpha1.UnhealthyCondition {
+	var matched *remediationv1alpha1.ConditionProfile
+	matchedSpecificity := -1
+	for i := range nhc.Spec.ConditionProfiles {
+		profile := &nhc.Spec.ConditionProfiles[i]
+		selector, err := metav1.LabelSelectorAsSelector(&profile.Selector)
+		if err != nil {
+			r.Log.Error(err, "failed converting a condition profile selector, skipping the profile", "index", i)
+			continue
+		}
+		if !selector.Matches(labels.Set(n.GetLabels())) {
+			continue
+		}
+		if nhc.Spec.ConditionProfileResolution != remediationv1alpha1.ConditionProfileResolutionMostSpecific {
+			return profile.UnhealthyConditions
+		}
+		// the most specific match wins, ties fall back to list order
+		if specificity := profileSpecificity(profile); specificity > matchedSpecificity {
+			matched = profile
+			matchedSpecificity = specificity
+		}
+	}
+	if matched != nil {
+		return matched.UnhealthyConditions
+	}
+	return nhc.Spec.UnhealthyConditions
+}
+
+// profileSpecificity counts the match labels and expressions of the profile's
+// selector, as the specificity measure of the MostSpecific profile resolution.
+func profileSpecificity(profile *remediationv1alpha1.ConditionProfile) int {
+	return len(profile.Selector.MatchLabels) + len(profile.Selector.MatchExpressions)
+}
+
+// shortestConditionDuration returns the shortest positive duration among the
+// NHC's unhealthy conditions, including those of its condition profiles, or
+// zero when there is none.
+func shortestConditionDuration(nhc *remediationv1alpha1.NodeHealthCheck) time.Duration {
+	conditionSets := [][]remediationv1alpha1.UnhealthyCondition{nhc.Spec.UnhealthyConditions}
+	for i := range nhc.Spec.ConditionProfiles {
+		conditionSets = append(conditionSets, nhc.Spec.ConditionProfiles[i].UnhealthyConditions)
+	}
+	shortest := time.Duration(0)
+	for _, conditions := range conditionSets {
+		for _, condition := range conditions {
+			if condition.Duration.Duration <= 0 {
+				continue
+			}
+			if shortest == 0 || condition.Duration.Duration < shortest {
+				shortest = condition.Duration.Duration
+			}
+		}
+	}
+	return shortest
+}
+
+// hostsFailingWorkload checks whether the node hosts at least one pod from the
+// NHC's workload namespaces which is neither running with all containers ready
+// nor completed successfully.
+func (r *NodeHealthCheckReconciler) hostsFailingWorkload(nhc *remediationv1alpha1.NodeHealthCheck, nodeName string) bool {
+	for _, ns := range nhc.Spec.WorkloadNamespaces {
+		pods := &v1.PodList{}
+		err := r.List(context.Background(), pods, client.InNamespace(ns), client.MatchingFields{"spec.nodeName": nodeName})
+		if err != nil {
+			// without a reliable pod listing rather consider the workload failing
+			// than silently skipping remediation of an unhealthy node
+			r.Log.Error(err, "failed to list pods for the workload namespace check", "namespace", ns, "nodeName", nodeName)
+			return true
+		}
+		for i := range pods.Items {
+			if isPodFailing(&pods.Items[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isPodFailing reports whether the pod is neither running and ready nor
+// completed successfully.
+func isPodFailing(pod *v1.Pod) bool {
+	switch pod.Status.Phase {
+	case v1.PodSucceeded:
+		return false
+	case v1.PodRunning:
+		for _, c := range pod.Status.Conditions {
+			if c.Type == v1.PodReady {
+				return c.Status != v1.ConditionTrue
+			}
+		}
+		return true
+	default:
+		return true
+	}
+}
+
+// annotationsHealthy checks the node's annotations against the NHC's unhealthy
+// annotations, as set by external detectors which can't set node conditions.
+// A node is considered unhealthy once an annotation keeps matching for the
+// configured duration, measured from the write time recorded in the node's
+// managed fields when one is available, and from when the controller first
+// observed the annotation otherwise.
+func (r *NodeHealthCheckReconciler) annotationsHealthy(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	if len(nhc.Spec.UnhealthyAnnotations) == 0 {
+		return true
+	}
+
+	r.annotationFirstSeenLock.Lock()
+	defer r.annotationFirstSeenLock.Unlock()
+	if r.annotationFirstSeen == nil {
+		r.annotationFirstSeen = make(map[string]time.Time)
+	}
+
+	healthy := true
+	for _, annotation := range nhc.Spec.UnhealthyAnnotations {
+		firstSeenKey := fmt.Sprintf("%s/%s/%s", nhc.GetName(), n.GetName(), annotation.Key)
+		if n.GetAnnotations()[annotation.Key] != annotation.Value {
+			delete(r.annotationFirstSeen, firstSeenKey)
+			continue
+		}
+		firstSeen, exists := r.annotationFirstSeen[firstSeenKey]
+		if !exists {
+			// prefer the write time from the node's managed fields, which
+			// survives operator restarts; fall back to the first observation
+			if managed := annotationManagedTime(n, annotation.Key); managed != nil {
+				firstSeen = *managed
+			} else {
+				firstSeen = time.Now()
+			}
+			r.annotationFirstSeen[firstSeenKey] = firstSeen
+		}
+		if annotation.Duration == nil || !time.Now().Before(firstSeen.Add(annotation.Duration.Duration)) {
+			r.Log.Info("node carries an unhealthy annotation", "nodeName", n.Name,
+				"key", annotation.Key, "value", annotation.Value)
+			healthy = false
+		}
+	}
+	return healthy
+}
+
+// annotationManagedTime returns when the node's annotation was last written
+// according to the node's managed fields, or nil when no manager records it.
+// Managed fields only carry the writing manager's last update time, so the
+// returned time is an upper bound: at worst the annotation is older, which only
+// delays remediation, never triggers it early.
+func annotationManagedTime(n *v1.Node, key string) *time.Time {
+	var newest *time.Time
+	for i := range n.ManagedFields {
+		entry := &n.ManagedFields[i]
+		if entry.FieldsV1 == nil || entry.Time == nil {
+			continue
+		}
+		if !fieldsV1ManagesAnnotation(entry.FieldsV1.Raw, key) {
+			continue
+		}
+		if newest == nil || entry.Time.Time.After(*newest) {
+			entryTime := entry.Time.Time
+			newest = &entryTime
+		}
+	}
+	return newest
+}
+
+// fieldsV1ManagesAnnotation reports whether the fieldsV1 set contains the
+// annotation with the given key, i.e. has the path f:metadata/f:annotations/
+// f:<key>. The raw JSON is walked instead of substring-matched, so a label or
+// other field with the same key doesn't count as the annotation.
+func fieldsV1ManagesAnnotation(raw []byte, key string) bool {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return false
+	}
+	metadata, ok := fields["f:metadata"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	annotations, ok := metadata["f:annotations"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	_, ok = annotations["f:"+key]
+	return ok
+}
+
+// taintsHealthy checks the node's taints against the NHC's unhealthy taints.
+// A node is considered unhealthy once a taint keeps matching for the
+// configured duration, measured from the taint's TimeAdded when set and from
+// when the controller first observed it otherwise.
+func (r *NodeHealthCheckReconciler) taintsHealthy(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	if len(nhc.Spec.UnhealthyTaints) == 0 {
+		return true
+	}
+
+	r.taintFirstSeenLock.Lock()
+	defer r.taintFirstSeenLock.Unlock()
+	if r.taintFirstSeen == nil {
+		r.taintFirstSeen = make(map[string]time.Time)
+	}
+
+	healthy := true
+	for _, unhealthyTaint := range nhc.Spec.UnhealthyTaints {
+		firstSeenKey := fmt.Sprintf("%s/%s/%s/%s", nhc.GetName(), n.GetName(), unhealthyTaint.Key, unhealthyTaint.Effect)
+		var taint *v1.Taint
+		for i := range n.Spec.Taints {
+			if n.Spec.Taints[i].Key == unhealthyTaint.Key &&
+				(unhealthyTaint.Effect == "" || n.Spec.Taints[i].Effect == unhealthyTaint.Effect) {
+				taint = &n.Spec.Taints[i]
+				break
+			}
+		}
+		if taint == nil {
+			delete(r.taintFirstSeen, firstSeenKey)
+			continue
+		}
+		taintedSince, exists := r.taintFirstSeen[firstSeenKey]
+		if !exists {
+			taintedSince = time.Now()
+			if taint.TimeAdded != nil {
+				taintedSince = taint.TimeAdded.Time
+			}
+			r.taintFirstSeen[firstSeenKey] = taintedSince
+		}
+		if unhealthyTaint.Duration == nil || !time.Now().Before(taintedSince.Add(unhealthyTaint.Duration.Duration)) {
+			r.Log.Info("node carries an unhealthy taint", "nodeName", n.Name,
+				"key", unhealthyTaint.Key, "effect", unhealthyTaint.Effect)
+			healthy = false
+		}
+	}
+	return healthy
+}
+
+// conditionsNotFlapping checks the node's conditions against the NHC's flap
+// thresholds. Each status transition the controller observes is remembered by
+// its transition time; a node is considered unhealthy once a watched condition
+// transitioned more often within the window than the threshold allows.
+func (r *NodeHealthCheckReconciler) conditionsNotFlapping(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	if len(nhc.Spec.ConditionFlapThresholds) == 0 {
+		return true
+	}
+
+	r.conditionTransitionsLock.Lock()
+	defer r.conditionTransitionsLock.Unlock()
+	if r.conditionTransitions == nil {
+		r.conditionTransitions = make(map[string][]time.Time)
+	}
+
+	now := time.Now()
+	healthy := true
+	for _, threshold := range nhc.Spec.ConditionFlapThresholds {
+		var lastTransition time.Time
+		for _, condition := range n.Status.Conditions {
+			if condition.Type == threshold.Type {
+				lastTransition = condition.LastTransitionTime.Time
+				break
+			}
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", nhc.GetName(), n.GetName(), threshold.Type)
+		transitions := r.conditionTransitions[key]
+		// record the transition unless it was observed already
+		if !lastTransition.IsZero() && (len(transitions) == 0 || transitions[len(transitions)-1].Before(lastTransition)) {
+			transitions = append(transitions, lastTransition)
+		}
+		// drop transitions which left the window
+		pruned := transitions[:0]
+		for _, transition := range transitions {
+			if now.Sub(transition) <= threshold.Window.Duration {
+				pruned = append(pruned, transition)
+			}
+		}
+		r.conditionTransitions[key] = pruned
+
+		if len(pruned) > threshold.MaxTransitions {
+			r.Log.Info("node condition is flapping", "nodeName", n.Name, "condition type", threshold.Type,
+				"transitions", len(pruned), "maxTransitions", threshold.MaxTransitions, "window", threshold.Window.Duration)
+			healthy = false
+		}
+	}
+	return healthy
+}
+
+// podDisruptionsHealthy checks the rate of pod OOM kills and evictions
+// attributed to the node against the NHC's pod disruption threshold. A node
+// under memory pressure may stay Ready while OOM-killing its workloads; once
+// more disruptions than allowed were observed within the window the node is
+// considered unhealthy. A failing pod listing degrades gracefully to healthy.
+func (r *NodeHealthCheckReconciler) podDisruptionsHealthy(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	threshold := nhc.Spec.PodDisruptionThreshold
+	if threshold == nil {
+		return true
+	}
+
+	pods := &v1.PodList{}
+	if err := r.List(context.Background(), pods, client.MatchingFields{"spec.nodeName": n.GetName()}); err != nil {
+		// a failing pod listing must not mark nodes unhealthy
+		r.Log.Error(err, "failed to list the node's pods for the pod disruption check", "nodeName", n.GetName())
+		return true
+	}
+
+	r.podDisruptionsLock.Lock()
+	defer r.podDisruptionsLock.Unlock()
+	if r.podDisruptions == nil {
+		r.podDisruptions = make(map[string]time.Time)
+	}
+
+	now := time.Now()
+	prefix := fmt.Sprintf("%s/%s/", nhc.GetName(), n.GetName())
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		// evictions don't leave a timestamp in the pod status, so the first
+		// observation time is recorded instead
+		if pod.Status.Phase == v1.PodFailed && pod.Status.Reason == "Evicted" {
+			key := prefix + string(pod.GetUID())
+			if _, seen := r.podDisruptions[key]; !seen {
+				r.podDisruptions[key] = now
+			}
+			continue
+		}
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			for _, terminated := range []*v1.ContainerStateTerminated{containerStatus.State.Terminated, containerStatus.LastTerminationState.Terminated} {
+				if terminated == nil || terminated.Reason != "OOMKilled" {
+					continue
+				}
+				key := fmt.Sprintf("%s%s/%s/%d", prefix, pod.GetUID(), containerStatus.Name, terminated.FinishedAt.Unix())
+				if _, seen := r.podDisruptions[key]; !seen {
+					r.podDisruptions[key] = terminated.FinishedAt.Time
+				}
+			}
+		}
+	}
+
+	// count the node's disruptions still within the window, dropping the rest
+	disruptions := 0
+	for key, disruptionTime := range r.podDisruptions {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if now.Sub(disruptionTime) > threshold.Window.Duration {
+			delete(r.podDisruptions, key)
+			continue
+		}
+		disruptions++
+	}
+
+	if disruptions > threshold.MaxDisruptedPods {
+		r.Log.Info("node keeps disrupting pods", "nodeName", n.Name,
+			"disruptions", disruptions, "maxDisruptedPods", threshold.MaxDisruptedPods, "window", threshold.Window.Duration)
+		return false
+	}
+	return true
+}
+
+// kubeletVersionCurrent checks the node's reported kubelet version against the
+// NHC's minimum kubelet version. A node whose kubelet stays below the minimum
+// for the configured duration becomes a remediation candidate, so a node stuck
+// on an old kubelet after a failed upgrade gets reprovisioned. An unparsable
+// version degrades gracefully to healthy.
+func (r *NodeHealthCheckReconciler) kubeletVersionCurrent(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	if nhc.Spec.MinKubeletVersion == "" {
+		return true
+	}
+
+	below, err := kubeletVersionBelow(n.Status.NodeInfo.KubeletVersion, nhc.Spec.MinKubeletVersion)
+	if err != nil {
+		// an unparsable version must not mark nodes unhealthy
+		r.Log.Error(err, "failed to compare the node's kubelet version against the minimum", "nodeName", n.GetName(),
+			"kubeletVersion", n.Status.NodeInfo.KubeletVersion, "minKubeletVersion", nhc.Spec.MinKubeletVersion)
+		return true
+	}
+
+	r.kubeletOutdatedSinceLock.Lock()
+	defer r.kubeletOutdatedSinceLock.Unlock()
+	if r.kubeletOutdatedSince == nil {
+		r.kubeletOutdatedSince = make(map[string]time.Time)
+	}
+
+	key := fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName())
+	if !below {
+		delete(r.kubeletOutdatedSince, key)
+		return true
+	}
+	outdatedSince, exists := r.kubeletOutdatedSince[key]
+	if !exists {
+		outdatedSince = time.Now()
+		r.kubeletOutdatedSince[key] = outdatedSince
+	}
+	if nhc.Spec.MinKubeletVersionDuration != nil && time.Now().Before(outdatedSince.Add(nhc.Spec.MinKubeletVersionDuration.Duration)) {
+		// mid-upgrade grace, the kubelet may still come up on the new version
+		return true
+	}
+	r.Log.Info("node's kubelet version is below the minimum", "nodeName", n.Name,
+		"kubeletVersion", n.Status.NodeInfo.KubeletVersion, "minKubeletVersion", nhc.Spec.MinKubeletVersion)
+	return false
+}
+
+// kubeletOutdatedNodes returns the names of the NHC's nodes which are currently
+// remediation candidates because of an outdated kubelet, for reporting them
+// distinctly from failure-driven remediation in the status.
+func (r *NodeHealthCheckReconciler) kubeletOutdatedNodes(nhc *remediationv1alpha1.NodeHealthCheck) map[string]bool {
+	r.kubeletOutdatedSinceLock.Lock()
+	defer r.kubeletOutdatedSinceLock.Unlock()
+	nodes := make(map[string]bool)
+	prefix := nhc.GetName() + "/"
+	for key, outdatedSince := range r.kubeletOutdatedSince {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if nhc.Spec.MinKubeletVersionDuration != nil && time.Now().Before(outdatedSince.Add(nhc.Spec.MinKubeletVersionDuration.Duration)) {
+			continue
+		}
+		nodes[strings.TrimPrefix(key, prefix)] = true
+	}
+	return nodes
+}
+
+// kubeletVersionBelow compares two kubelet version strings like "v1.25.3",
+// reporting whether version is semantically below minVersion. Pre-release and
+// build suffixes are ignored.
+func kubeletVersionBelow(version string, minVersion string) (bool, error) {
+	parsed, err := parseKubeletVersion(version)
+	if err != nil {
+		return false, err
+	}
+	parsedMin, err := parseKubeletVersion(minVersion)
+	if err != nil {
+		return false, err
+	}
+	for i := range parsed {
+		if parsed[i] != parsedMin[i] {
+			return parsed[i] < parsedMin[i], nil
+		}
+	}
+	return false, nil
+}
+
+// parseKubeletVersion parses a version string like "v1.25.3" into its numeric
+// major, minor and patch parts. Missing parts default to zero.
+func parseKubeletVersion(version string) ([3]int, error) {
+	var parsed [3]int
+	trimmed := strings.TrimPrefix(version, "v")
+	// strip pre-release and build metadata suffixes
+	if index := strings.IndexAny(trimmed, "-+"); index >= 0 {
+		trimmed = trimmed[:index]
+	}
+	parts := strings.Split(trimmed, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return parsed, errors.Errorf("unexpected version format %q", version)
+	}
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return parsed, errors.Wrapf(err, "unexpected version format %q", version)
+		}
+		parsed[i] = number
+	}
+	return parsed, nil
+}
+
+// kubeletCertExpiryPatterns are condition reason and message fragments known to
+// show up on a node when the kubelet's client certificate expired and the
+// kubelet can no longer authenticate against the API server.
+var kubeletCertExpiryPatterns = []string{
+	"x509: certificate has expired",
+	"certificate has expired or is not yet valid",
+	"the server has asked for the client to provide credentials",
+}
+
+// kubeletCertValid checks the node's conditions for known kubelet certificate
+// expiry patterns. An expired kubelet client certificate silently stops status
+// updates and otherwise only surfaces through the generic Ready condition, so
+// when opted in, such a node becomes a remediation candidate with a dedicated
+// reason in the status' unhealthy nodes.
+func (r *NodeHealthCheckReconciler) kubeletCertValid(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	if !nhc.Spec.RemediateOnKubeletCertExpiry {
+		return true
+	}
+	if !kubeletCertExpired(n) {
+		return true
+	}
+	r.Log.Info("node reports an expired kubelet certificate", "nodeName", n.GetName())
+	return false
+}
+
+// kubeletCertExpired reports whether one of the node's conditions carries a
+// known kubelet certificate expiry pattern.
+func kubeletCertExpired(n *v1.Node) bool {
+	for _, condition := range n.Status.Conditions {
+		for _, pattern := range kubeletCertExpiryPatterns {
+			if strings.Contains(condition.Message, pattern) || strings.Contains(condition.Reason, pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// probeResult is the cached outcome of a node's HTTP probe, taken by a
+// background worker.
+type probeResult struct {
+	healthy  bool
+	probedAt time.Time
+}
+
+// probeHealthy checks the node against the NHC's HTTP probe, if one is
+// configured. The probes run asynchronously and feed a result cache, so this
+// only reads the node's latest cached outcome and kicks off a fresh probe; a
+// node without a cached result yet counts as healthy. A node is considered
+// probe-unhealthy once the cached probes keep failing for the configured
+// duration.
+func (r *NodeHealthCheckReconciler) probeHealthy(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	probe := nhc.Spec.HTTPProbe
+	if probe == nil {
+		return true
+	}
+
+	key := fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName())
+	result, cached := r.scheduleProbe(key, nhc, n)
+	if !cached {
+		return true
+	}
+
+	r.probeFailuresLock.Lock()
+	defer r.probeFailuresLock.Unlock()
+	if result.healthy {
+		delete(r.probeFailures, key)
+		return true
+	}
+	if r.probeFailures == nil {
+		r.probeFailures = make(map[string]time.Time)
+	}
+	failingSince, exists := r.probeFailures[key]
+	if !exists {
+		failingSince = result.probedAt
+		r.probeFailures[key] = failingSince
+	}
+	if probe.Duration == nil {
+		return false
+	}
+	return time.Now().Before(failingSince.Add(probe.Duration.Duration))
+}
+
+// scheduleProbe returns the node's cached probe result and starts a fresh
+// probe in the background, unless one is already in flight for the node. At
+// most maxConcurrentProbes probes run at once, the rest queue up in their
+// goroutines.
+func (r *NodeHealthCheckReconciler) scheduleProbe(key string, nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) (probeResult, bool) {
+	probe := nhc.Spec.HTTPProbe
+
+	nodeIP := ""
+	for _, address := range n.Status.Addresses {
+		if address.Type == v1.NodeInternalIP {
+			nodeIP = address.Address
+			break
+		}
+	}
+	probeURL := strings.NewReplacer("{{.NodeName}}", n.GetName(), "{{.NodeIP}}", nodeIP).Replace(probe.URLTemplate)
+	timeout := 5 * time.Second
+	if probe.Timeout != nil {
+		timeout = probe.Timeout.Duration
+	}
+	expectedStatus := probe.ExpectedStatus
+	if expectedStatus == 0 {
+		expectedStatus = http.StatusOK
+	}
+	failureIsUnhealthy := probe.ProbeFailureIsUnhealthy
+	nodeName := n.GetName()
+
+	r.probeResultsLock.Lock()
+	defer r.probeResultsLock.Unlock()
+	result, cached := r.probeResults[key]
+	if r.probeInFlight[key] {
+		return result, cached
+	}
+	if r.probeInFlight == nil {
+		r.probeInFlight = make(map[string]bool)
+	}
+	if r.probeWorkers == nil {
+		r.probeWorkers = make(chan struct{}, maxConcurrentProbes)
+	}
+	r.probeInFlight[key] = true
+	go func() {
+		r.probeWorkers <- struct{}{}
+		defer func() { <-r.probeWorkers }()
+		healthy := r.probeOnce(probeURL, timeout, expectedStatus, failureIsUnhealthy, nodeName)
+		r.probeResultsLock.Lock()
+		defer r.probeResultsLock.Unlock()
+		if r.probeResults == nil {
+			r.probeResults = make(map[string]probeResult)
+		}
+		r.probeResults[key] = probeResult{healthy: healthy, probedAt: time.Now()}
+		delete(r.probeInFlight, key)
+	}()
+	return result, cached
+}
+
+// probeOnce performs a single HTTP probe and reports whether its outcome
+// counts as healthy.
+func (r *NodeHealthCheckReconciler) probeOnce(probeURL string, timeout time.Duration, expectedStatus int, failureIsUnhealthy bool, nodeName string) bool {
+	httpClient := http.Client{Timeout: timeout}
+	resp, err := httpClient.Get(probeURL)
+	if err != nil {
+		if failureIsUnhealthy {
+			r.Log.Info("HTTP probe failed", "nodeName", nodeName, "url", probeURL, "error", err.Error())
+			return false
+		}
+		return true
+	}
+	resp.Body.Close()
+	return resp.StatusCode == expectedStatus
+}
+
+// resourcesAvailable checks the node's allocatable resources against the NHC's
+// resource availability conditions. A node is considered unhealthy once one of
+// the watched resources stays below its minimum for the configured duration.
+func (r *NodeHealthCheckReconciler) resourcesAvailable(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	if len(nhc.Spec.ResourceAvailabilityConditions) == 0 {
+		return true
+	}
+
+	r.resourceShortagesLock.Lock()
+	defer r.resourceShortagesLock.Unlock()
+	if r.resourceShortages == nil {
+		r.resourceShortages = make(map[string]time.Time)
+	}
+
+	available := true
+	for _, condition := range nhc.Spec.ResourceAvailabilityConditions {
+		// a resource missing from the allocatable list counts as zero
+		allocatable := n.Status.Allocatable[condition.ResourceName]
+		shortageKey := fmt.Sprintf("%s/%s/%s", nhc.GetName(), n.GetName(), condition.ResourceName)
+		if allocatable.Cmp(condition.MinAllocatable) >= 0 {
+			delete(r.resourceShortages, shortageKey)
+			continue
+		}
+		shortSince, exists := r.resourceShortages[shortageKey]
+		if !exists {
+			shortSince = time.Now()
+			r.resourceShortages[shortageKey] = shortSince
+		}
+		if condition.Duration == nil || !time.Now().Before(shortSince.Add(condition.Duration.Duration)) {
+			r.Log.Info("node reports too little allocatable resource", "nodeName", n.Name,
+				"resource", condition.ResourceName, "allocatable", allocatable.String(), "minAllocatable", condition.MinAllocatable.String())
+			available = false
+		}
+	}
+	return available
+}
+
+func (r *NodeHealthCheckReconciler) markHealthy(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) error {
+	// with a verify period configured, a remediated node which just turned
+	// healthy must stay healthy for the whole period before the remediation is
+	// declared successful and cleaned up. Turning unhealthy again within the
+	// period keeps the remediation in place, so it escalates or re-remediates.
+	if nhc.Spec.PostRemediationVerifyPeriod != nil {
+		if _, inFlight := nhc.Status.InFlightRemediations[n.GetName()]; inFlight && !r.postRemediationVerified(n, nhc) {
+			r.Log.Info("node turned healthy, verifying it stays healthy before declaring the remediation successful",
+				"nodeName", n.GetName(), "verifyPeriod", nhc.Spec.PostRemediationVerifyPeriod.Duration)
+			return nil
+		}
+	}
+
+	// a healthy node starts from scratch should it fail again
+	r.clearRemediationExhausted(context.Background(), n, nhc)
+	r.clearDrainedPods(n, nhc)
+	r.clearCanaryNode(n, nhc)
+	r.clearNotifiedUnhealthy(n, nhc)
+	r.clearDryRunRemediations(n, nhc)
+
+	// collected for the completion notification
+	removedSteps := 0
+	removedKind := ""
+	var oldestRemediation time.Time
+
+	for _, templateRef := range trackedTemplateRefs(nhc) {
+		cr := remediationCRShim(templateRef, n.GetName())
+
+		// check if CR is deleted already
+		err := r.Client.Get(context.Background(), client.ObjectKeyFromObject(cr), cr)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		} else if apierrors.IsNotFound(err) || cr.GetDeletionTimestamp() != nil {
+			if err == nil {
+				r.handleStuckTerminatingCR(cr, n, nhc)
+			}
+			continue
+		}
+
+		r.Log.V(5).Info("node seems healthy", "Node name", n.Name)
+
+		// release the protection finalizer, the remediation completed
+		if err = r.removeProtectionFinalizer(context.Background(), cr); err != nil {
+			return err
+		}
+
+		err = r.Client.Delete(context.Background(), cr, &client.DeleteOptions{})
+		// if the node is already healthy then there is no remediation object for it
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+
+		if err == nil {
+			// deleted an actual object
+			r.Log.Info("deleted node external remediation object", "Node name", n.Name)
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonRemediationRemoved, "",
+				fmt.Sprintf("Deleted remediation object for node %s", n.Name))
+			failedTerminally := remediationFailedTerminally(nhc, cr)
+			r.recordRemediatorIncident(nhc, cr.GetKind(), failedTerminally)
+			outcome := remediationOutcomeRecovered
+			if failedTerminally {
+				outcome = "failed"
+			}
+			r.recordRemediationCompleted(nhc, n.GetName(), cr.GetKind(), outcome)
+			metrics.ObserveNodeHealthCheckRemediationToHealthy(nhc.GetName(), cr.GetKind(), time.Since(cr.GetCreationTimestamp().Time).Seconds())
+			r.releaseNodeLease(context.Background(), n.GetName(), nhc)
+			removedSteps++
+			removedKind = cr.GetKind()
+			if created := cr.GetCreationTimestamp().Time; oldestRemediation.IsZero() || created.Before(oldestRemediation) {
+				oldestRemediation = created
+			}
+		}
+	}
+	if removedSteps > 0 && nhc.Spec.RemediationResultURL != "" {
+		r.getNotifier().notify(nhc.Spec.RemediationResultURL, remediationResult{
+			NodeHealthCheck: nhc.GetName(),
+			Node:            n.GetName(),
+			Outcome:         remediationOutcomeRecovered,
+			Remediator:      removedKind,
+			Duration:        time.Since(oldestRemediation).Round(time.Second).String(),
+			EscalationSteps: removedSteps,
+			CompletedAt:     metav1.Now(),
+		})
+	}
+	// the remediation just completed, so a still-cordoned node was left that
+	// way by the remediator, not by an unrelated actor
+	if removedSteps > 0 && nhc.Spec.UncordonAfterRemediation {
+		if err := r.uncordon(n, nhc); err != nil {
+			return err
+		}
+	}
+	if nhc.Spec.RemediationJobTemplate != nil {
+		return r.deleteRemediationJob(n, nhc)
+	}
+	return nil
+}
+
+// uncordon makes a recovered node schedulable again when the remediator left it
+// cordoned. It is only called right after this NHC cleaned up the node's
+// remediation object, keeping the un-cordon within the window of the node's own
+// remediation; a node cordoned at any other time is never touched.
+func (r *NodeHealthCheckReconciler) uncordon(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) error {
+	node := &v1.Node{}
+	if err := r.Client.Get(context.Background(), client.ObjectKey{Name: n.GetName()}, node); err != nil {
+		return err
+	}
+	if !node.Spec.Unschedulable {
+		return nil
+	}
+	base := node.DeepCopy()
+	node.Spec.Unschedulable = false
+	if err := r.Client.Patch(context.Background(), node, client.MergeFrom(base)); err != nil {
+		return err
+	}
+	r.Log.Info("un-cordoned the recovered node, the remediator left it unschedulable", "nodeName", node.GetName())
+	r.nodeEvent(nhc, eventTypeNormal, eventReasonNodeUncordoned, "",
+		fmt.Sprintf("Un-cordoned node %s, it recovered but its remediation left it unschedulable", node.GetName()))
+	return nil
+}
+
+// handleStuckTerminatingCR detects a remediation CR whose deletion doesn't
+// complete because a finalizer is stuck. The node is healthy already, but as
+// long as the CR exists it is counted as an in-flight remediation, skewing the
+// concurrency and min-healthy math indefinitely. The first detection fires a
+// warning event and a metric; when the operator is configured to do so, the
+// remaining finalizers are force-removed so the deletion completes.
+func (r *NodeHealthCheckReconciler) handleStuckTerminatingCR(cr *unstructured.Unstructured, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	if time.Now().Before(cr.GetDeletionTimestamp().Add(stuckTerminatingTimeout)) {
+		return
+	}
+
+	if r.RemoveStuckRemediationFinalizers {
+		if len(cr.GetFinalizers()) == 0 {
+			return
+		}
+		cr.SetFinalizers(nil)
+		if err := r.Client.Update(context.Background(), cr); err != nil {
+			r.Log.Error(err, "failed to remove the finalizers of a stuck terminating remediation CR", "name", cr.GetName())
+			return
+		}
+		metrics.ObserveNodeHealthCheckStuckTerminatingRemediation(nhc.GetName(), cr.GetKind())
+		r.Recorder.Event(nhc, eventTypeWarning, eventReasonRemediationStuck,
+			fmt.Sprintf("Removed the finalizers of the remediation object for node %s, its deletion was stuck for more than %s", n.Name, stuckTerminatingTimeout))
+		return
+	}
+
+	// alert once per CR, flagged like the old remediation alert
+	annotations := cr.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if _, alerted := annotations[stuckTerminatingAnnotationKey]; alerted {
+		return
+	}
+	annotations[stuckTerminatingAnnotationKey] = "flagon"
+	cr.SetAnnotations(annotations)
+	if err := r.Client.Update(context.Background(), cr); err != nil {
+		r.Log.Error(err, "failed to flag a stuck terminating remediation CR", "name", cr.GetName())
+		return
+	}
+	metrics.ObserveNodeHealthCheckStuckTerminatingRemediation(nhc.GetName(), cr.GetKind())
+	r.Recorder.Event(nhc, eventTypeWarning, eventReasonRemediationStuck,
+		fmt.Sprintf("Deletion of the remediation object for node %s is stuck for more than %s on finalizers %v, the node stays counted as an in-flight remediation", n.Name, stuckTerminatingTimeout, cr.GetFinalizers()))
+}
+
+// getNotifier lazily creates the shared result notifier with its background
+// delivery goroutine.
+func (r *NodeHealthCheckReconciler) getNotifier() *notifier {
+	r.resultNotifierLock.Lock()
+	defer r.resultNotifierLock.Unlock()
+	if r.resultNotifier == nil {
+		r.resultNotifier = newNotifier(r.Log.WithName("notifier"))
+	}
+	return r.resultNotifier
+}
+
+// postRemediationVerified checks whether the remediated node stayed healthy for
+// the NHC's whole post remediation verify period. The first healthy observation
+// starts the clock; an unhealthy observation in between resets it.
+func (r *NodeHealthCheckReconciler) postRemediationVerified(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) bool {
+	r.verifyHealthySinceLock.Lock()
+	defer r.verifyHealthySinceLock.Unlock()
+	if r.verifyHealthySince == nil {
+		r.verifyHealthySince = make(map[string]time.Time)
+	}
+
+	key := fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName())
+	healthySince, exists := r.verifyHealthySince[key]
+	if !exists {
+		healthySince = r.now()
+		r.verifyHealthySince[key] = healthySince
+	}
+	if r.now().Before(healthySince.Add(nhc.Spec.PostRemediationVerifyPeriod.Duration)) {
+		return false
+	}
+	delete(r.verifyHealthySince, key)
+	return true
+}
+
+// clearVerifyHealthySince resets the node's post remediation verification clock,
+// once the node is observed unhealthy again.
+func (r *NodeHealthCheckReconciler) clearVerifyHealthySince(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	r.verifyHealthySinceLock.Lock()
+	defer r.verifyHealthySinceLock.Unlock()
+	delete(r.verifyHealthySince, fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName()))
+}
+
+// nodesUnderVerification returns the names of the NHC's nodes which are healthy
+// again after remediation but still within the post remediation verify period.
+func (r *NodeHealthCheckReconciler) nodesUnderVerification(nhc *remediationv1alpha1.NodeHealthCheck) []string {
+	r.verifyHealthySinceLock.Lock()
+	defer r.verifyHealthySinceLock.Unlock()
+	var nodes []string
+	prefix := nhc.GetName() + "/"
+	for key := range r.verifyHealthySince {
+		if strings.HasPrefix(key, prefix) {
+			nodes = append(nodes, strings.TrimPrefix(key, prefix))
+		}
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// deleteRemediationJob deletes the node's remediation job, including its pods,
+// once the node turned healthy again, mirroring the remediation CR lifecycle.
+func (r *NodeHealthCheckReconciler) deleteRemediationJob(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) error {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		return errors.Wrap(err, "failed to determine the namespace of the remediation job")
+	}
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: n.GetName(), Namespace: ns}}
+	err = r.Client.Get(context.Background(), client.ObjectKeyFromObject(job), job)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	} else if apierrors.IsNotFound(err) || job.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	if err := r.Client.Delete(context.Background(), job, &client.DeleteOptions{PropagationPolicy: &propagation}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	jobFailed := false
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == v1.ConditionTrue {
+			jobFailed = true
+		}
+	}
+	r.Log.Info("deleted node remediation job", "Node name", n.Name)
+	r.nodeEvent(nhc, eventTypeNormal, eventReasonRemediationRemoved, "",
+		fmt.Sprintf("Deleted remediation job for node %s", n.Name))
+	r.recordRemediatorIncident(nhc, "Job", jobFailed)
+	metrics.ObserveNodeHealthCheckRemediationToHealthy(nhc.GetName(), "Job", time.Since(job.GetCreationTimestamp().Time).Seconds())
+	r.releaseNodeLease(context.Background(), n.GetName(), nhc)
+	return nil
+}
+
+// releaseProtectedRemediations removes the protection finalizer from all of the
+// NHC's remediation CRs, so deleting the NHC doesn't leave them undeletable.
+func (r *NodeHealthCheckReconciler) releaseProtectedRemediations(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck) error {
+	for _, templateRef := range trackedTemplateRefs(nhc) {
+		cr := remediationCRShim(templateRef, "")
+		crList := &unstructured.UnstructuredList{Object: cr.Object}
+		if err := r.Client.List(ctx, crList); err != nil {
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to fetch the remediation objects of kind %s", cr.GroupVersionKind())
+		}
+		for i := range crList.Items {
+			if err := r.removeProtectionFinalizer(ctx, &crList.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeProtectionFinalizer removes the protection finalizer from the remediation
+// CR, if it carries one.
+func (r *NodeHealthCheckReconciler) removeProtectionFinalizer(ctx context.Context, cr *unstructured.Unstructured) error {
+	finalizers := cr.GetFinalizers()
+	for i, finalizer := range finalizers {
+		if finalizer == protectInFlightFinalizer {
+			cr.SetFinalizers(append(finalizers[:i], finalizers[i+1:]...))
+			if err := r.Client.Update(ctx, cr); err != nil && !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "failed to remove the protection finalizer from %s %q", cr.GetKind(), cr.GetName())
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// nodeLeaseStatus returns the status representation of the node's remediation
+// lease, or nil when no lease exists or the deployment namespace is unknown.
+func (r *NodeHealthCheckReconciler) nodeLeaseStatus(nodeName string) *remediationv1alpha1.NodeLeaseStatus {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		return nil
+	}
+	lease := &coordv1.Lease{}
+	if err := r.Client.Get(context.Background(), client.ObjectKey{Namespace: ns, Name: leaseNamePrefix + nodeName}, lease); err != nil {
+		return nil
+	}
+
+	status := &remediationv1alpha1.NodeLeaseStatus{}
+	if lease.Spec.HolderIdentity != nil {
+		status.Holder = *lease.Spec.HolderIdentity
+	}
+	if lease.Spec.AcquireTime != nil {
+		acquired := metav1.NewTime(lease.Spec.AcquireTime.Time)
+		status.AcquiredAt = &acquired
+	}
+	renewed := lease.Spec.RenewTime
+	if renewed == nil {
+		renewed = lease.Spec.AcquireTime
+	}
+	if renewed != nil && lease.Spec.LeaseDurationSeconds != nil {
+		expires := metav1.NewTime(renewed.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second))
+		status.ExpiresAt = &expires
+	}
+	return status
+}
+
+// recordRemediatorIncident records a completed incident for the remediator kind,
+// both as a metric and as a pending stat merged into the NHC's status on the
+// next status patch.
+func (r *NodeHealthCheckReconciler) recordRemediatorIncident(nhc *remediationv1alpha1.NodeHealthCheck, remediator string, failed bool) {
+	outcome := "succeeded"
+	if failed {
+		outcome = "failed"
+	}
+	metrics.ObserveNodeHealthCheckRemediatorIncident(nhc.GetName(), remediator, outcome)
+
+	r.pendingRemediatorStatsLock.Lock()
+	defer r.pendingRemediatorStatsLock.Unlock()
+	if r.pendingRemediatorStats == nil {
+		r.pendingRemediatorStats = make(map[string]map[string]remediationv1alpha1.RemediatorStat)
+	}
+	stats := r.pendingRemediatorStats[nhc.GetName()]
+	if stats == nil {
+		stats = make(map[string]remediationv1alpha1.RemediatorStat)
+		r.pendingRemediatorStats[nhc.GetName()] = stats
+	}
+	stat := stats[remediator]
+	if failed {
+		stat.Failed++
+	} else {
+		stat.Succeeded++
+	}
+	stats[remediator] = stat
+}
+
+// recordRemediationStarted records a new remediation history entry for the
+// node, merged into the NHC's status on the next status patch.
+func (r *NodeHealthCheckReconciler) recordRemediationStarted(nhc *remediationv1alpha1.NodeHealthCheck, nodeName string, remediator string) {
+	r.pendingHistoryLock.Lock()
+	defer r.pendingHistoryLock.Unlock()
+	if r.pendingHistoryStarts == nil {
+		r.pendingHistoryStarts = make(map[string][]remediationv1alpha1.RemediationHistoryEntry)
+	}
+	r.pendingHistoryStarts[nhc.GetName()] = append(r.pendingHistoryStarts[nhc.GetName()],
+		remediationv1alpha1.RemediationHistoryEntry{
+			Node:       nodeName,
+			Remediator: remediator,
+			StartedAt:  metav1.Now(),
+		})
+}
+
+// recordRemediationCompleted records the completion of the node's in-flight
+// remediation history entry, merged into the NHC's status on the next status
+// patch, and feeds the rolling success rate computation.
+func (r *NodeHealthCheckReconciler) recordRemediationCompleted(nhc *remediationv1alpha1.NodeHealthCheck, nodeName string, remediator string, outcome string) {
+	r.pendingHistoryLock.Lock()
+	defer r.pendingHistoryLock.Unlock()
+	if r.pendingHistoryCompletions == nil {
+		r.pendingHistoryCompletions = make(map[string][]remediationv1alpha1.RemediationHistoryEntry)
+	}
+	now := metav1.Now()
+	r.pendingHistoryCompletions[nhc.GetName()] = append(r.pendingHistoryCompletions[nhc.GetName()],
+		remediationv1alpha1.RemediationHistoryEntry{
+			Node:        nodeName,
+			Remediator:  remediator,
+			CompletedAt: &now,
+			Outcome:     outcome,
+		})
+
+	r.remediationOutcomesLock.Lock()
+	defer r.remediationOutcomesLock.Unlock()
+	if r.remediationOutcomes == nil {
+		r.remediationOutcomes = make(map[string][]remediationOutcome)
+	}
+	r.remediationOutcomes[nhc.GetName()] = append(r.remediationOutcomes[nhc.GetName()],
+		remediationOutcome{
+			completedAt: r.now(),
+			recovered:   outcome == remediationOutcomeRecovered,
+		})
+}
+
+// remediationOutcome is one completed remediation, kept in memory for the
+// rolling success rate computation.
+type remediationOutcome struct {
+	completedAt time.Time
+	recovered   bool
+}
+
+// RemediationSuccessRateWindow is the rolling window over which the
+// remediation success rate status field and metric are computed. It is set
+// from an operator flag.
+var RemediationSuccessRateWindow = 24 * time.Hour
+
+// remediationSuccessCounts returns how many remediations of the NHC completed
+// within the success rate window and how many of them recovered their node,
+// pruning the entries which fell out of the window on the way.
+func (r *NodeHealthCheckReconciler) remediationSuccessCounts(nhc *remediationv1alpha1.NodeHealthCheck) (recovered int, total int) {
+	r.remediationOutcomesLock.Lock()
+	defer r.remediationOutcomesLock.Unlock()
+	cutoff := r.now().Add(-RemediationSuccessRateWindow)
+	var kept []remediationOutcome
+	for _, outcome := range r.remediationOutcomes[nhc.GetName()] {
+		if outcome.completedAt.Before(cutoff) {
+			continue
+		}
+		kept = append(kept, outcome)
+		total++
+		if outcome.recovered {
+			recovered++
+		}
+	}
+	if kept == nil {
+		delete(r.remediationOutcomes, nhc.GetName())
+	} else {
+		r.remediationOutcomes[nhc.GetName()] = kept
+	}
+	return
+}
+
+// popPendingHistory returns and clears the history entries recorded for the
+// NHC since the last status patch.
+func (r *NodeHealthCheckReconciler) popPendingHistory(nhcName string) (starts []remediationv1alpha1.RemediationHistoryEntry, completions []remediationv1alpha1.RemediationHistoryEntry) {
+	r.pendingHistoryLock.Lock()
+	defer r.pendingHistoryLock.Unlock()
+	starts = r.pendingHistoryStarts[nhcName]
+	completions = r.pendingHistoryCompletions[nhcName]
+	delete(r.pendingHistoryStarts, nhcName)
+	delete(r.pendingHistoryCompletions, nhcName)
+	return
+}
+
+// pruneRemediationHistory drops completed history entries beyond the NHC's
+// HistoryLimit, oldest first, and entries which completed longer than the
+// HistoryTTL ago. In-flight entries are never pruned.
+func pruneRemediationHistory(history []remediationv1alpha1.RemediationHistoryEntry, nhc *remediationv1alpha1.NodeHealthCheck) []remediationv1alpha1.RemediationHistoryEntry {
+	limit := defaultHistoryLimit
+	if nhc.Spec.HistoryLimit != nil {
+		limit = *nhc.Spec.HistoryLimit
+	}
+	completed := 0
+	for i := range history {
+		if history[i].CompletedAt != nil {
+			completed++
+		}
+	}
+
+	var pruned []remediationv1alpha1.RemediationHistoryEntry
+	for _, entry := range history {
+		if entry.CompletedAt == nil {
+			pruned = append(pruned, entry)
+			continue
+		}
+		expired := nhc.Spec.HistoryTTL != nil && time.Since(entry.CompletedAt.Time) > nhc.Spec.HistoryTTL.Duration
+		if expired || completed > limit {
+			completed--
+			continue
+		}
+		pruned = append(pruned, entry)
+	}
+	return pruned
+}
+
+// popPendingRemediatorStats returns and clears the remediator stats recorded
+// for the NHC since the last status patch.
+func (r *NodeHealthCheckReconciler) popPendingRemediatorStats(nhcName string) map[string]remediationv1alpha1.RemediatorStat {
+	r.pendingRemediatorStatsLock.Lock()
+	defer r.pendingRemediatorStatsLock.Unlock()
+	stats := r.pendingRemediatorStats[nhcName]
+	delete(r.pendingRemediatorStats, nhcName)
+	return stats
+}
+
+// ClockSkewTolerance is added to the unhealthy condition durations when
+// comparing against the node-reported transition times, so slightly skewed
+// node clocks don't cause premature remediation. The tradeoff is added
+// remediation latency of up to the tolerance. It is set from an operator
+// flag and defaults to zero, preserving the exact configured durations.
+var ClockSkewTolerance time.Duration
+
+// matchingUnhealthyConditionCount counts how many of the given unhealthy
+// conditions currently match the node's conditions, each past its duration.
+func matchingUnhealthyConditionCount(conditionTests []remediationv1alpha1.UnhealthyCondition, nodeConditions []v1.NodeCondition) int {
+	return len(matchingUnhealthyConditions(conditionTests, nodeConditions))
+}
+
+// matchingUnhealthyConditions returns the given unhealthy conditions which
+// currently match the node's conditions, each past its duration.
+func matchingUnhealthyConditions(conditionTests []remediationv1alpha1.UnhealthyCondition, nodeConditions []v1.NodeCondition) []remediationv1alpha1.UnhealthyCondition {
+	now := time.Now()
+	nodeConditionByType := make(map[v1.NodeConditionType]v1.NodeCondition)
+	for _, nc := range nodeConditions {
+		nodeConditionByType[nc.Type] = nc
+	}
+
+	var matching []remediationv1alpha1.UnhealthyCondition
+	for _, c := range conditionTests {
+		n, exists := nodeConditionByType[c.Type]
+		if !exists {
+			continue
+		}
+		if n.Status == c.Status && now.After(n.LastTransitionTime.Add(c.Duration.Duration).Add(ClockSkewTolerance)) {
+			matching = append(matching, c)
+		}
+	}
+	return matching
+}
+
+// conditionsHealthy checks the node's conditions against the NHC's unhealthy
+// conditions. By default a single matching condition makes the node unhealthy;
+// with MinUnhealthyConditionCount set, the node needs at least that many
+// distinct matching conditions before it becomes a remediation candidate.
+func (r *NodeHealthCheckReconciler) conditionsHealthy(nhc *remediationv1alpha1.NodeHealthCheck, n *v1.Node) bool {
+	minCount := nhc.Spec.MinUnhealthyConditionCount
+	if minCount < 1 {
+		minCount = 1
+	}
+	return matchingUnhealthyConditionCount(r.conditionsForNode(nhc, n), n.Status.Conditions) < minCount
+}
+
+// dedupKey computes a deterministic idempotency key for the remediation of the
+// given node by the given NHC. Remediators can use it to recognize a re-created
+// CR as the same incident. Returns an empty string when the node has no UID,
+// e.g. for the empty node used for listing remediation CRs.
+func (r *NodeHealthCheckReconciler) dedupKey(nhc *remediationv1alpha1.NodeHealthCheck, node *v1.Node) string {
+	if node.GetUID() == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s", nhc.GetUID(), node.GetUID(), r.unhealthySince(nhc, node))))
+	return hex.EncodeToString(hash[:])
+}
+
+// unhealthySince returns the earliest transition time of the node conditions
+// currently matching the NHC's unhealthy conditions, or the zero time if none match.
+func (r *NodeHealthCheckReconciler) unhealthySince(nhc *remediationv1alpha1.NodeHealthCheck, node *v1.Node) string {
+	since := r.unhealthyTransition(nhc, node)
+	if since == nil {
+		return ""
+	}
+	return since.UTC().Format(time.RFC3339)
+}
+
+// resolveInheritance overlays the settings of the NHC's InheritFrom parent
+// chain onto fields the NHC leaves unset, in memory only; the stored object
+// stays untouched. Returns an error on inheritance cycles.
+func (r *NodeHealthCheckReconciler) resolveInheritance(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck) error {
+	visited := map[string]bool{nhc.GetName(): true}
+	child := nhc
+	for child.Spec.InheritFrom != nil {
+		parentName := child.Spec.InheritFrom.Name
+		if visited[parentName] {
+			return errors.Errorf("inheritance cycle detected involving NodeHealthCheck %q", parentName)
+		}
+		visited[parentName] = true
+		parent := &remediationv1alpha1.NodeHealthCheck{}
+		if err := r.Get(ctx, client.ObjectKey{Name: parentName}, parent); err != nil {
+			return errors.Wrapf(err, "failed to fetch the inherited NodeHealthCheck %q", parentName)
+		}
+		if len(nhc.Spec.Selector.MatchLabels) == 0 && len(nhc.Spec.Selector.MatchExpressions) == 0 {
+			nhc.Spec.Selector = parent.Spec.Selector
+		}
+		if len(nhc.Spec.UnhealthyConditions) == 0 {
+			nhc.Spec.UnhealthyConditions = parent.Spec.UnhealthyConditions
+		}
+		if nhc.Spec.MinHealthy == nil {
+			nhc.Spec.MinHealthy = parent.Spec.MinHealthy
+		}
+		if nhc.Spec.RemediationTemplate == nil && nhc.Spec.RemediationJobTemplate == nil && len(nhc.Spec.EscalatingRemediations) == 0 {
+			nhc.Spec.RemediationTemplate = parent.Spec.RemediationTemplate
+		}
+		child = parent
+	}
+	return nil
+}
+
+// isTargetNode reports whether the node is part of the NHC's target node campaign.
+func isTargetNode(nhc *remediationv1alpha1.NodeHealthCheck, nodeName string) bool {
+	for _, name := range nhc.Spec.TargetNodes {
+		if name == nodeName {
+			return true
+		}
+	}
+	return false
+}
+
+// targetNodesRemaining reports whether the NHC's target node campaign still
+// lists nodes which weren't remediated yet.
+func targetNodesRemaining(nhc *remediationv1alpha1.NodeHealthCheck) bool {
+	remediated := make(map[string]bool, len(nhc.Status.RemediatedTargetNodes))
+	for _, name := range nhc.Status.RemediatedTargetNodes {
+		remediated[name] = true
+	}
+	for _, name := range nhc.Spec.TargetNodes {
+		if !remediated[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// unhealthyTransition returns the earliest transition time of the node conditions
+// currently matching the unhealthy conditions the node is checked against,
+// including those of a matching condition profile, or nil if none match.
+func (r *NodeHealthCheckReconciler) unhealthyTransition(nhc *remediationv1alpha1.NodeHealthCheck, node *v1.Node) *metav1.Time {
+	var since *metav1.Time
+	for _, c := range r.conditionsForNode(nhc, node) {
+		for _, nc := range node.Status.Conditions {
+			if nc.Type == c.Type && nc.Status == c.Status {
+				if since == nil || nc.LastTransitionTime.Before(since) {
+					t := nc.LastTransitionTime
+					since = &t
+				}
+			}
+		}
+	}
+	return since
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeHealthCheckReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// index pods by node name for the workload namespace check
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1.Pod{}, "spec.nodeName", func(object client.Object) []string {
+		return []string{object.(*v1.Pod).Spec.NodeName}
+	}); err != nil {
+		return err
+	}
+	// like the default controller rate limiter, but with the per-NHC base delay
+	// raised to the configured minimum reconcile interval, so a flapping NHC is
+	// retried no faster than that. Requeues are delayed, never dropped, so the
+	// latest state is always reconciled eventually.
+	rateLimiter := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(r.minReconcileInterval(), 1000*time.Second),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+	c, err := ctrl.NewControllerManagedBy(mgr).
+		For(&remediationv1alpha1.NodeHealthCheck{}).
+		Watches(&source.Kind{Type: &v1.Node{}}, handler.EnqueueRequestsFromMapFunc(utils.NHCByNodeMapperFunc(mgr.GetClient(), mgr.GetLogger()))).
+		Watches(&source.Kind{Type: &remediationv1alpha1.NodeHealthCheck{}}, handler.EnqueueRequestsFromMapFunc(utils.NHCByInheritanceMapperFunc(mgr.GetClient()))).
+		WithOptions(controller.Options{RateLimiter: rateLimiter}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+	// the remediation template GVKs are only known at runtime, their watches are
+	// added while reconciling
+	r.templateWatchController = c
+	return nil
+}
+
+// ensureTemplateWatches adds a watch for each remediation template kind the NHC
+// references, mapping template events back to the NHCs referencing them. This
+// way fixing a broken template, e.g. by adding the missing spec.template,
+// promptly re-enables a disabled NHC instead of waiting for the next resync.
+// Watches are added on first sight of a GVK and kept for the manager's
+// lifetime; failures are logged and retried on the next reconcile.
+func (r *NodeHealthCheckReconciler) ensureTemplateWatches(nhc *remediationv1alpha1.NodeHealthCheck) {
+	if r.templateWatchController == nil {
+		return
+	}
+	r.watchedTemplateGVKsLock.Lock()
+	defer r.watchedTemplateGVKsLock.Unlock()
+	if r.watchedTemplateGVKs == nil {
+		r.watchedTemplateGVKs = make(map[schema.GroupVersionKind]bool)
+	}
+	for _, templateRef := range remediationTemplateRefs(nhc) {
+		gvk := templateRef.GroupVersionKind()
+		if gvk.Empty() || r.watchedTemplateGVKs[gvk] {
+			continue
+		}
+		template := &unstructured.Unstructured{}
+		template.SetGroupVersionKind(gvk)
+		if err := r.templateWatchController.Watch(&source.Kind{Type: template},
+			handler.EnqueueRequestsFromMapFunc(utils.NHCByTemplateMapperFunc(r.Client))); err != nil {
+			r.Log.Error(err, "failed to add a watch for a remediation template kind", "gvk", gvk)
+			continue
+		}
+		r.Log.Info("watching remediation template kind", "gvk", gvk)
+		r.watchedTemplateGVKs[gvk] = true
+	}
+}
+
+func (r *NodeHealthCheckReconciler) remediate(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*time.Duration, bool, error) {
+	if nhc.Spec.DryRun {
+		return r.remediateDryRun(n, nhc)
+	}
+	if nhc.Spec.RemediationJobTemplate != nil {
+		return r.remediateWithJob(ctx, n, nhc)
+	}
+	if len(nhc.Spec.EscalatingRemediations) > 0 {
+		if nhc.Spec.RemediationTemplate != nil {
+			// rejected by the webhook, but old objects may still carry both;
+			// deterministically prefer the escalating remediations
+			r.Log.Info("warning: both remediationTemplate and escalatingRemediations are set, using the escalating remediations",
+				"NodeHealthCheck", nhc.Name)
+		}
+		return r.remediateEscalating(ctx, n, nhc)
+	}
+
+	cr, err := r.generateRemediationCR(n, nhc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// check if CR already exists
+	if err = r.Client.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+		if !apierrors.IsNotFound(err) {
+			r.Log.Error(err, "failed to check for existing external remediation object")
+			return nil, false, err
+		}
+
+		// a node marked exhausted by the Delete timeout policy is not remediated again
+		if r.remediationExhausted(n, nhc) {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedExhausted, "remediation exhausted",
+				fmt.Sprintf("Skipped remediation of node %s, its timed out remediation was deleted and is not retried", n.Name))
+			return nil, true, nil
+		}
+
+		// ask the configured peer for confirmation, to avoid partition-induced mass remediation
+		if !r.peerConfirmed(n, nhc) {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedPeerHealthy, "peer reported healthy",
+				fmt.Sprintf("Skipped remediation of node %s because the peer reported it as healthy", n.Name))
+			requeue := 1 * time.Minute
+			return &requeue, false, nil
+		}
+
+		// wait for an explicit approval before destructive remediation, when required
+		if approved, requeue, err := r.remediationApproved(ctx, n, nhc); err != nil {
+			return nil, false, err
+		} else if !approved {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedApprovalPending, "approval pending",
+				fmt.Sprintf("Waiting for approval before remediating node %s", n.Name))
+			return &requeue, false, nil
+		}
+
+		// space out CR creations to avoid overwhelming provisioning infrastructure
+		if wait := r.remediationSpacingDelay(nhc); wait > 0 {
+			r.Log.Info("deferring remediation to honor the minimum delay between remediations",
+				"nodeName", n.Name, "wait", wait)
+			return &wait, false, nil
+		}
+
+		// strictly lease-first: without a confirmed lease the node must not be remediated
+		if !r.acquireNodeLeaseConfirmed(ctx, n, nhc) {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedLease, "lease not confirmed",
+				fmt.Sprintf("Deferred remediation of node %s, the remediation lease could not be confirmed", n.Name))
+			requeue := 1 * time.Minute
+			return &requeue, false, nil
+		}
+
+		// drain the node's pods before the remediation starts, when configured
+		r.preRemediationDrain(ctx, n, nhc)
+
+		// create CR
+		r.Log.Info("node seems unhealthy. Creating an external remediation object",
+			"nodeName", n.Name, "CR name", cr.GetName(), "CR gvk", cr.GroupVersionKind(), "ns", cr.GetNamespace())
+		if err = r.Client.Create(ctx, cr); err != nil {
+			r.Log.Error(err, "failed to create an external remediation object")
+			return nil, false, err
+		}
+		r.nodeEvent(nhc, eventTypeNormal, eventReasonRemediationCreated, "",
+			fmt.Sprintf("Created remediation object for node %s", n.Name))
+		r.recordRemediationStarted(nhc, n.GetName(), cr.GetKind())
+		if transition := r.unhealthyTransition(nhc, n); transition != nil {
+			metrics.ObserveNodeHealthCheckRemediationCreationDelay(nhc.GetName(), cr.GetKind(), time.Since(transition.Time).Seconds())
+		}
+		return nil, false, nil
+	}
+
+	// CR exists; renew the lease so it doesn't expire while the remediation is
+	// still in flight
+	r.acquireNodeLease(ctx, n, nhc)
+	if remediationCRSpecOutdated(nhc, cr) {
+		r.Log.Info("the in-flight remediation was created under an earlier generation of the NHC spec",
+			"nodeName", n.Name, "CR name", cr.GetName())
+	}
+	if remediationFailedTerminally(nhc, cr) {
+		r.nodeEvent(nhc, eventTypeWarning, eventReasonRemediationFailed, "",
+			fmt.Sprintf("Remediation of node %s failed terminally, the remediator reported a terminal failure condition", n.Name))
+		return nil, true, nil
+	}
+
+	// without escalating remediations there is no further step to escalate a
+	// timed out remediation to; apply the configured timeout policy instead
+	if r.now().After(cr.GetCreationTimestamp().Add(crAlertTimeout(nhc))) {
+		switch nhc.Spec.OnTimeoutPolicy {
+		case remediationv1alpha1.OnTimeoutPolicyRecreate:
+			if err := r.removeProtectionFinalizer(ctx, cr); err != nil {
+				return nil, false, err
+			}
+			if err := r.Client.Delete(ctx, cr); err != nil && !apierrors.IsNotFound(err) {
+				return nil, false, err
+			}
+			r.nodeEvent(nhc, eventTypeWarning, eventReasonRemediationRemoved, "",
+				fmt.Sprintf("Deleted the timed out remediation object for node %s, a new one will be created to retry", n.Name))
+			r.recordRemediationCompleted(nhc, n.GetName(), cr.GetKind(), "failed")
+			requeue := 1 * time.Minute
+			return &requeue, false, nil
+		case remediationv1alpha1.OnTimeoutPolicyDelete:
+			if err := r.removeProtectionFinalizer(ctx, cr); err != nil {
+				return nil, false, err
+			}
+			if err := r.Client.Delete(ctx, cr); err != nil && !apierrors.IsNotFound(err) {
+				return nil, false, err
+			}
+			r.releaseNodeLease(ctx, n.GetName(), nhc)
+			r.markRemediationExhausted(ctx, n, nhc)
+			r.recordRemediationCompleted(nhc, n.GetName(), cr.GetKind(), remediationOutcomeExhausted)
+			r.nodeEvent(nhc, eventTypeWarning, eventReasonRemediationFailed, "",
+				fmt.Sprintf("Deleted the timed out remediation object for node %s, the node's remediation is exhausted", n.Name))
+			if nhc.Spec.RemediationResultURL != "" {
+				r.getNotifier().notify(nhc.Spec.RemediationResultURL, remediationResult{
+					NodeHealthCheck: nhc.GetName(),
+					Node:            n.GetName(),
+					Outcome:         remediationOutcomeExhausted,
+					Remediator:      cr.GetKind(),
+					Duration:        time.Since(cr.GetCreationTimestamp().Time).Round(time.Second).String(),
+					EscalationSteps: 1,
+					CompletedAt:     metav1.Now(),
+				})
+			}
+			return nil, true, nil
+		}
+	}
+
+	isAlert, nextReconcile := r.alertOldRemediationCR(nhc, cr)
+	if isAlert {
+		metrics.ObserveNodeHealthCheckOldRemediationCR(n.Name, n.Namespace)
+	}
+	return nextReconcile, false, nil
+}
+
+// remediateDryRun computes the remediation CR the node would get and records
+// it as a synthetic status entry instead of creating it, so platform teams can
+// validate selectors and the MinHealthy math before arming remediation. The
+// entry and its event are recorded once per node and CR, not on every
+// reconcile, and are cleared when the node turns healthy again.
+func (r *NodeHealthCheckReconciler) remediateDryRun(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*time.Duration, bool, error) {
+	if nhc.Spec.RemediationJobTemplate != nil {
+		r.Log.Info("dry run: job-based remediation is not simulated, no remediation CR would be created", "nodeName", n.Name)
+		return nil, false, nil
+	}
+	var templateRef *v1.ObjectReference
+	if escalations := escalationsInOrder(nhc); len(escalations) > 0 {
+		// the first escalation step would act first
+		templateRef = &escalations[0].RemediationTemplate
+	} else {
+		templateRef = templateRefForNode(n, nhc)
+	}
+	if templateRef == nil {
+		r.Log.Info("dry run: no remediation method configured, no remediation CR would be created", "nodeName", n.Name)
+		return nil, false, nil
+	}
+	cr, err := r.generateRemediationCRForTemplate(n, nhc, templateRef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	recorded := r.recordDryRunRemediation(n, nhc, remediationv1alpha1.Remediation{
+		Kind:      cr.GetKind(),
+		Name:      cr.GetName(),
+		Namespace: cr.GetNamespace(),
+		DryRun:    true,
+	})
+	if recorded {
+		r.nodeEvent(nhc, eventTypeNormal, eventReasonDryRunRemediation, "dry run",
+			fmt.Sprintf("Dry run: would have created a %s remediation object for node %s", cr.GetKind(), n.Name))
+	}
+	return nil, false, nil
+}
+
+// recordDryRunRemediation remembers the synthetic dry-run entry for the node,
+// reporting whether it is new, so the dry-run event fires once per would-be CR.
+func (r *NodeHealthCheckReconciler) recordDryRunRemediation(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck, remediation remediationv1alpha1.Remediation) bool {
+	r.dryRunRemediationsLock.Lock()
+	defer r.dryRunRemediationsLock.Unlock()
+	key := fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName())
+	for _, existing := range r.dryRunRemediations[key] {
+		if existing.Kind == remediation.Kind && existing.Name == remediation.Name && existing.Namespace == remediation.Namespace {
+			return false
+		}
+	}
+	if r.dryRunRemediations == nil {
+		r.dryRunRemediations = make(map[string][]remediationv1alpha1.Remediation)
+	}
+	r.dryRunRemediations[key] = append(r.dryRunRemediations[key], remediation)
+	return true
+}
+
+// nodeDryRunRemediations returns the synthetic dry-run entries recorded for
+// the node, surfaced in the unhealthy node status.
+func (r *NodeHealthCheckReconciler) nodeDryRunRemediations(nodeName string, nhc *remediationv1alpha1.NodeHealthCheck) []remediationv1alpha1.Remediation {
+	r.dryRunRemediationsLock.Lock()
+	defer r.dryRunRemediationsLock.Unlock()
+	return r.dryRunRemediations[fmt.Sprintf("%s/%s", nhc.GetName(), nodeName)]
+}
+
+// clearDryRunRemediations forgets the node's dry-run entries once it turned
+// healthy, so a future failure is reported from scratch.
+func (r *NodeHealthCheckReconciler) clearDryRunRemediations(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	r.dryRunRemediationsLock.Lock()
+	defer r.dryRunRemediationsLock.Unlock()
+	delete(r.dryRunRemediations, fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName()))
+}
+
+// markRemediationExhausted remembers that the node's timed out remediation was
+// deleted by the Delete timeout policy and must not be retried while the node
+// stays unhealthy. The exhaustion is persisted as a node annotation scoped to
+// the incident's dedup key, so it survives operator restarts and leader
+// failovers; the in-memory map covers node-like resources, which can't be
+// annotated here.
+func (r *NodeHealthCheckReconciler) markRemediationExhausted(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	r.exhaustedRemediationsLock.Lock()
+	if r.exhaustedRemediations == nil {
+		r.exhaustedRemediations = make(map[string]bool)
+	}
+	r.exhaustedRemediations[fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName())] = true
+	r.exhaustedRemediationsLock.Unlock()
+
+	if nhc.Spec.NodeResourceRef != nil {
+		return
+	}
+	node := &v1.Node{}
+	if err := r.Get(ctx, client.ObjectKey{Name: n.GetName()}, node); err != nil {
+		r.Log.Error(err, "failed to persist the remediation exhaustion", "nodeName", n.GetName())
+		return
+	}
+	base := node.DeepCopy()
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[exhaustedAnnotationKey] = r.dedupKey(nhc, n)
+	if err := r.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		r.Log.Error(err, "failed to persist the remediation exhaustion", "nodeName", n.GetName())
+	}
+}
+
+// remediationExhausted reports whether the node's remediation was exhausted by
+// the Delete timeout policy, either per this process's memory or per the
+// persisted exhaustion annotation of the same incident.
+func (r *NodeHealthCheckReconciler) remediationExhausted(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) bool {
+	r.exhaustedRemediationsLock.Lock()
+	exhausted := r.exhaustedRemediations[fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName())]
+	r.exhaustedRemediationsLock.Unlock()
+	if exhausted {
+		return true
+	}
+	key := r.dedupKey(nhc, n)
+	return key != "" && n.GetAnnotations()[exhaustedAnnotationKey] == key
+}
+
+// clearRemediationExhausted forgets the node's exhaustion once it turned
+// healthy again, so a future failure is remediated from scratch. The persisted
+// annotation is removed as well: a new incident wouldn't match its dedup key,
+// but a stale marker would confuse operators inspecting the node.
+func (r *NodeHealthCheckReconciler) clearRemediationExhausted(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	r.exhaustedRemediationsLock.Lock()
+	delete(r.exhaustedRemediations, fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName()))
+	r.exhaustedRemediationsLock.Unlock()
+
+	if _, exists := n.GetAnnotations()[exhaustedAnnotationKey]; !exists || nhc.Spec.NodeResourceRef != nil {
+		return
+	}
+	node := &v1.Node{}
+	if err := r.Get(ctx, client.ObjectKey{Name: n.GetName()}, node); err != nil {
+		r.Log.Error(err, "failed to clear the persisted remediation exhaustion", "nodeName", n.GetName())
+		return
+	}
+	if _, exists := node.Annotations[exhaustedAnnotationKey]; !exists {
+		return
+	}
+	base := node.DeepCopy()
+	delete(node.Annotations, exhaustedAnnotationKey)
+	if err := r.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+		r.Log.Error(err, "failed to clear the persisted remediation exhaustion", "nodeName", n.GetName())
+	}
+}
+
+// preRemediationDrain evicts pods from the unhealthy node right before its
+// remediation CR is created, per the NHC's drain policy. With "Full" all
+// evictable pods are evicted, with "LowPriorityOnly" only those whose priority
+// is below the configured threshold. DaemonSet-managed and mirror pods are
+// never evicted. The drain is best effort: eviction failures, e.g. from pod
+// disruption budgets, are logged and don't block the remediation.
+func (r *NodeHealthCheckReconciler) preRemediationDrain(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	policy := nhc.Spec.PreRemediationDrainPolicy
+	if policy == "" || policy == remediationv1alpha1.DrainPolicyNone || r.ClientSet == nil || nhc.Spec.NodeResourceRef != nil {
+		return
+	}
+
+	pods := &v1.PodList{}
+	if err := r.List(ctx, pods, client.MatchingFields{"spec.nodeName": n.GetName()}); err != nil {
+		r.Log.Error(err, "failed to list the pods for the pre-remediation drain", "nodeName", n.GetName())
+		return
+	}
+
+	var threshold int32
+	if nhc.Spec.PreRemediationDrainPriorityThreshold != nil {
+		threshold = *nhc.Spec.PreRemediationDrainPriorityThreshold
+	}
+
+	drained := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		if isDaemonSetPod(pod) || isMirrorPod(pod) {
+			continue
+		}
+		if policy == remediationv1alpha1.DrainPolicyLowPriorityOnly && podPriority(pod) >= threshold {
+			continue
+		}
+		eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}
+		if err := r.ClientSet.CoreV1().Pods(pod.Namespace).EvictV1(ctx, eviction); err != nil {
+			r.Log.Error(err, "failed to evict a pod during the pre-remediation drain",
+				"nodeName", n.GetName(), "pod", pod.Namespace+"/"+pod.Name)
+			continue
+		}
+		drained++
+	}
+	if drained > 0 {
+		r.Log.Info("drained pods before remediation", "nodeName", n.GetName(), "policy", policy, "drainedPods", drained)
+		r.addDrainedPods(n, nhc, drained)
+	}
+}
+
+// isDaemonSetPod reports whether the pod is controlled by a DaemonSet, which
+// would recreate an evicted pod on the same node right away.
+func isDaemonSetPod(pod *v1.Pod) bool {
+	for _, owner := range pod.GetOwnerReferences() {
+		if owner.Controller != nil && *owner.Controller && owner.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
+
+// isMirrorPod reports whether the pod mirrors a static pod, which the kubelet
+// manages outside the API server and which cannot be evicted.
+func isMirrorPod(pod *v1.Pod) bool {
+	_, mirror := pod.GetAnnotations()[v1.MirrorPodAnnotationKey]
+	return mirror
+}
+
+// podPriority returns the pod's priority, treating pods without one as
+// priority 0, matching the scheduler's interpretation.
+func podPriority(pod *v1.Pod) int32 {
+	if pod.Spec.Priority != nil {
+		return *pod.Spec.Priority
+	}
+	return 0
+}
+
+// addDrainedPods accumulates the node's drained pod count, surfaced in the
+// unhealthy node status.
+func (r *NodeHealthCheckReconciler) addDrainedPods(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck, count int) {
+	r.drainedPodsLock.Lock()
+	defer r.drainedPodsLock.Unlock()
+	if r.drainedPods == nil {
+		r.drainedPods = make(map[string]int)
+	}
+	r.drainedPods[fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName())] += count
+}
+
+// drainedPodCount returns how many pods the pre-remediation drain evicted from
+// the node.
+func (r *NodeHealthCheckReconciler) drainedPodCount(nodeName string, nhc *remediationv1alpha1.NodeHealthCheck) int {
+	r.drainedPodsLock.Lock()
+	defer r.drainedPodsLock.Unlock()
+	return r.drainedPods[fmt.Sprintf("%s/%s", nhc.GetName(), nodeName)]
+}
+
+// clearDrainedPods forgets the node's drained pod count once it turned healthy
+// again, so a future incident starts counting from scratch.
+func (r *NodeHealthCheckReconciler) clearDrainedPods(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	r.drainedPodsLock.Lock()
+	defer r.drainedPodsLock.Unlock()
+	delete(r.drainedPods, fmt.Sprintf("%s/%s", nhc.GetName(), n.GetName()))
+}
+
+// evaluateCanary elects or checks the canary node of the current remediation
+// wave. It returns the canary's name while the remaining unhealthy nodes must
+// hold, or an empty string when remediation may proceed for all of them. A
+// restarted operator rederives its canary from the single in-flight
+// remediation; only a wave already released to several nodes is never
+// re-gated. The caller passes the unhealthy nodes sorted worst-first, making
+// the longest-unhealthy node the canary.
+func (r *NodeHealthCheckReconciler) evaluateCanary(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck, unhealthyNodes []v1.Node, inFlight map[string]metav1.Time) (string, error) {
+	r.canaryNodesLock.Lock()
+	canary, waveActive := r.canaryNodes[nhc.GetName()]
+	if !waveActive {
+		switch len(inFlight) {
+		case 0:
+			canary = unhealthyNodes[0].GetName()
+			waveActive = true
+			r.Log.Info("remediating a canary node first, deferring the other unhealthy nodes until it recovers",
+				"nodeName", canary)
+		case 1:
+			// an operator restart dropped the in-memory wave: the single
+			// in-flight remediation is the canary which was remediated first,
+			// so rederive it instead of releasing all deferred nodes at once
+			// against a still unproven remediator
+			for nodeName := range inFlight {
+				canary = nodeName
+			}
+			waveActive = true
+			r.Log.Info("rederived the canary node from the single in-flight remediation",
+				"nodeName", canary)
+		}
+		if waveActive {
+			if r.canaryNodes == nil {
+				r.canaryNodes = make(map[string]string)
+			}
+			r.canaryNodes[nhc.GetName()] = canary
+		}
+	}
+	r.canaryNodesLock.Unlock()
+	if !waveActive {
+		// several remediations already under way, the wave was released before
+		// the canary gate could apply, don't gate
+		return "", r.updateCanaryFailedCondition(ctx, nhc, "", false)
+	}
+
+	failed, err := r.canaryFailed(ctx, nhc, canary)
+	if err != nil {
+		return "", err
+	}
+	return canary, r.updateCanaryFailedCondition(ctx, nhc, canary, failed)
+}
+
+// canaryFailed reports whether the canary node's remediation failed terminally,
+// stopped without success, or timed out, so continuing with the remaining nodes
+// would repeat a remediation which evidently doesn't work.
+func (r *NodeHealthCheckReconciler) canaryFailed(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck, canary string) (bool, error) {
+	for _, templateRef := range trackedTemplateRefs(nhc) {
+		cr := remediationCRShim(templateRef, canary)
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if remediationFailedTerminally(nhc, cr) || remediationGaveUp(nhc, cr) ||
+			r.now().After(cr.GetCreationTimestamp().Add(crAlertTimeout(nhc))) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// updateCanaryFailedCondition surfaces a failed or timed out canary remediation
+// via the CanaryFailed condition: the remaining unhealthy nodes stay deferred
+// until the canary node recovers, which needs the operator's attention. The
+// condition is set to False again once no failed canary holds remediation back.
+func (r *NodeHealthCheckReconciler) updateCanaryFailedCondition(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck, canary string, failed bool) error {
+	condition := meta.FindStatusCondition(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeCanaryFailed)
+	if failed && (condition == nil || condition.Status != metav1.ConditionTrue) {
+		message := fmt.Sprintf("The canary remediation of node %s failed or timed out, the remaining unhealthy nodes are not remediated. "+
+			"Recover the canary node to resume, or disable canaryRemediation", canary)
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeCanaryFailed,
+			Status:  metav1.ConditionTrue,
+			Reason:  remediationv1alpha1.ConditionReasonCanaryRemediationFailed,
+			Message: message,
+		})
+		r.Recorder.Event(nhc, eventTypeWarning, eventReasonCanaryFailed, message)
+		return r.updateStatusConditions(ctx, nhc)
+	}
+	if !failed && condition != nil && condition.Status == metav1.ConditionTrue {
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeCanaryFailed,
+			Status:  metav1.ConditionFalse,
+			Reason:  remediationv1alpha1.ConditionReasonCanaryNotFailed,
+			Message: "No failed canary remediation holds back remediation",
+		})
+		return r.updateStatusConditions(ctx, nhc)
+	}
+	return nil
+}
+
+// clearCanaryNode ends the NHC's canary wave once the canary node returned to
+// health, releasing the deferred remediations of the remaining unhealthy nodes.
+func (r *NodeHealthCheckReconciler) clearCanaryNode(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	r.canaryNodesLock.Lock()
+	defer r.canaryNodesLock.Unlock()
+	if r.canaryNodes[nhc.GetName()] == n.GetName() {
+		delete(r.canaryNodes, nhc.GetName())
+	}
+}
+
+// remediateWithJob remediates the node by running a Job created from the NHC's
+// remediation job template, for clusters without an external remediation
+// provider. The node counts as remediated once the Job completes; the Job
+// follows the same lifecycle as remediation CRs and is deleted when the node
+// turns healthy again.
+func (r *NodeHealthCheckReconciler) remediateWithJob(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*time.Duration, bool, error) {
+	job, err := r.generateRemediationJob(n, nhc)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// check if the job already exists
+	if err = r.Client.Get(ctx, client.ObjectKeyFromObject(job), job); err != nil {
 		if !apierrors.IsNotFound(err) {
+			r.Log.Error(err, "failed to check for existing remediation job")
+			return nil, false, err
+		}
+
+		// ask the configured peer for confirmation, to avoid partition-induced mass remediation
+		if !r.peerConfirmed(n, nhc) {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedPeerHealthy, "peer reported healthy",
+				fmt.Sprintf("Skipped remediation of node %s because the peer reported it as healthy", n.Name))
+			requeue := 1 * time.Minute
+			return &requeue, false, nil
+		}
+
+		// wait for an explicit approval before destructive remediation, when required
+		if approved, requeue, err := r.remediationApproved(ctx, n, nhc); err != nil {
+			return nil, false, err
+		} else if !approved {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedApprovalPending, "approval pending",
+				fmt.Sprintf("Waiting for approval before remediating node %s", n.Name))
+			return &requeue, false, nil
+		}
+
+		// space out job creations to avoid overwhelming provisioning infrastructure
+		if wait := r.remediationSpacingDelay(nhc); wait > 0 {
+			r.Log.Info("deferring remediation to honor the minimum delay between remediations",
+				"nodeName", n.Name, "wait", wait)
+			return &wait, false, nil
+		}
+
+		// strictly lease-first: without a confirmed lease the node must not be remediated
+		if !r.acquireNodeLeaseConfirmed(ctx, n, nhc) {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedLease, "lease not confirmed",
+				fmt.Sprintf("Deferred remediation of node %s, the remediation lease could not be confirmed", n.Name))
+			requeue := 1 * time.Minute
+			return &requeue, false, nil
+		}
+
+		r.Log.Info("node seems unhealthy. Creating a remediation job",
+			"nodeName", n.Name, "job name", job.GetName(), "ns", job.GetNamespace())
+		if err = r.Client.Create(ctx, job); err != nil {
+			r.Log.Error(err, "failed to create a remediation job")
+			return nil, false, err
+		}
+		r.nodeEvent(nhc, eventTypeNormal, eventReasonRemediationCreated, "",
+			fmt.Sprintf("Created remediation job for node %s", n.Name))
+		if transition := r.unhealthyTransition(nhc, n); transition != nil {
+			metrics.ObserveNodeHealthCheckRemediationCreationDelay(nhc.GetName(), "Job", time.Since(transition.Time).Seconds())
+		}
+		return nil, false, nil
+	}
+
+	// job exists
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			// the node counts as remediated, wait for it to report healthy again
+			return nil, false, nil
+		case batchv1.JobFailed:
+			r.nodeEvent(nhc, eventTypeWarning, eventReasonRemediationFailed, "",
+				fmt.Sprintf("Remediation job for node %s failed: %s", n.Name, condition.Message))
+			return nil, true, nil
+		}
+	}
+	// job is still running
+	requeue := 1 * time.Minute
+	return &requeue, false, nil
+}
+
+// generateRemediationJob builds the remediation job for the node from the NHC's
+// remediation job template, named after the node like remediation CRs are, with
+// the node name injected into the job's pod as the NODE_NAME environment variable.
+func (r *NodeHealthCheckReconciler) generateRemediationJob(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*batchv1.Job, error) {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine the namespace for the remediation job")
+	}
+
+	template := nhc.Spec.RemediationJobTemplate.DeepCopy()
+	job := &batchv1.Job{
+		ObjectMeta: template.ObjectMeta,
+		Spec:       template.Spec,
+	}
+	job.Name = n.GetName()
+	job.Namespace = ns
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels["app.kubernetes.io/part-of"] = "node-healthcheck-controller"
+	if key := r.dedupKey(nhc, n); key != "" {
+		if job.Annotations == nil {
+			job.Annotations = map[string]string{}
+		}
+		job.Annotations[dedupKeyAnnotationKey] = key
+	}
+	controller := pointer.BoolPtr(false)
+	var blockOwnerDeletion *bool
+	if ownerRef := nhc.Spec.RemediationOwnerReference; ownerRef != nil {
+		if ownerRef.Controller != nil {
+			controller = ownerRef.Controller
+		}
+		blockOwnerDeletion = ownerRef.BlockOwnerDeletion
+	}
+	job.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion:         nhc.APIVersion,
+			Kind:               nhc.Kind,
+			Name:               nhc.Name,
+			UID:                nhc.UID,
+			Controller:         controller,
+			BlockOwnerDeletion: blockOwnerDeletion,
+		},
+	}
+	for i := range job.Spec.Template.Spec.Containers {
+		job.Spec.Template.Spec.Containers[i].Env = append(job.Spec.Template.Spec.Containers[i].Env,
+			v1.EnvVar{Name: "NODE_NAME", Value: n.GetName()})
+	}
+	if job.Spec.Template.Spec.RestartPolicy == "" {
+		job.Spec.Template.Spec.RestartPolicy = v1.RestartPolicyNever
+	}
+	return job, nil
+}
+
+// remediateEscalating walks through the NHC's escalating remediations in order, creating
+// a remediation CR from the next template whenever the previous remediation timed out
+// or failed terminally. The second return value reports whether the last remediation
+// failed terminally with nothing left to escalate to.
+func (r *NodeHealthCheckReconciler) remediateEscalating(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*time.Duration, bool, error) {
+	escalations := escalationsInOrder(nhc)
+	escalated := false
+	for i := r.escalationStartIndex(n, nhc, escalations); i < len(escalations); i++ {
+		escalation := escalations[i]
+		cr, err := r.generateRemediationCRForTemplate(n, nhc, &escalation.RemediationTemplate)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if err = r.Client.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil && !apierrors.IsNotFound(err) {
 			r.Log.Error(err, "failed to check for existing external remediation object")
-			return nil, err
+			return nil, false, err
+		} else if err == nil {
+			// remediation is in flight; renew the lease so it doesn't expire
+			// while the escalation chain is still working on the node
+			r.acquireNodeLease(ctx, n, nhc)
+			failed := remediationFailedTerminally(nhc, cr) || remediationGaveUp(nhc, cr)
+			timeoutAt := cr.GetCreationTimestamp().Add(r.effectiveTimeout(n, nhc, escalation.Timeout.Duration))
+			if failed || r.now().After(timeoutAt) {
+				if i < len(escalations)-1 {
+					if failed {
+						r.Log.Info("remediation failed or stopped without success, escalating to the next remediation",
+							"nodeName", n.Name, "failed CR gvk", cr.GroupVersionKind(), "order", escalation.Order)
+					} else {
+						r.Log.Info("remediation timed out, escalating to the next remediation",
+							"nodeName", n.Name, "timed out CR gvk", cr.GroupVersionKind(), "order", escalation.Order)
+					}
+					escalated = true
+					continue
+				}
+				// last remediation timed out or failed, nothing left to escalate to
+				if failed {
+					r.nodeEvent(nhc, eventTypeWarning, eventReasonRemediationFailed, "",
+						fmt.Sprintf("Remediation of node %s failed terminally and all escalations are exhausted", n.Name))
+				}
+				return nil, failed, nil
+			}
+			requeue := timeoutAt.Sub(r.now()) + time.Minute
+			return &requeue, false, nil
+		}
+
+		// the node may have healed right at the escalation boundary; re-check with a
+		// fresh node before starting the next step, so a now-healthy node isn't remediated
+		if escalated {
+			healed, err := r.nodeHealed(ctx, n, nhc)
+			if err != nil {
+				return nil, false, err
+			}
+			if healed {
+				r.Log.Info("node healed during escalation, cancelling the remaining escalation steps", "nodeName", n.Name)
+				return nil, false, r.markHealthy(n, nhc)
+			}
+		}
+
+		// ask the configured peer for confirmation, to avoid partition-induced mass remediation
+		if !r.peerConfirmed(n, nhc) {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedPeerHealthy, "peer reported healthy",
+				fmt.Sprintf("Skipped remediation of node %s because the peer reported it as healthy", n.Name))
+			requeue := 1 * time.Minute
+			return &requeue, false, nil
+		}
+
+		// wait for an explicit approval before destructive remediation, when required
+		if approved, requeue, err := r.remediationApproved(ctx, n, nhc); err != nil {
+			return nil, false, err
+		} else if !approved {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedApprovalPending, "approval pending",
+				fmt.Sprintf("Waiting for approval before remediating node %s", n.Name))
+			return &requeue, false, nil
+		}
+
+		// space out CR creations to avoid overwhelming provisioning infrastructure
+		if wait := r.remediationSpacingDelay(nhc); wait > 0 {
+			r.Log.Info("deferring remediation to honor the minimum delay between remediations",
+				"nodeName", n.Name, "wait", wait)
+			return &wait, false, nil
+		}
+
+		// strictly lease-first: without a confirmed lease the node must not be remediated
+		if !r.acquireNodeLeaseConfirmed(ctx, n, nhc) {
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonSkippedLease, "lease not confirmed",
+				fmt.Sprintf("Deferred remediation of node %s, the remediation lease could not be confirmed", n.Name))
+			requeue := 1 * time.Minute
+			return &requeue, false, nil
+		}
+
+		// drain the node's pods before the incident's first remediation starts,
+		// when configured; later escalation steps find them evicted already
+		if !escalated {
+			r.preRemediationDrain(ctx, n, nhc)
+		}
+
+		r.Log.Info("node seems unhealthy. Creating an external remediation object",
+			"nodeName", n.Name, "CR name", cr.GetName(), "CR gvk", cr.GroupVersionKind(), "ns", cr.GetNamespace(), "order", escalation.Order)
+		if err = r.Client.Create(ctx, cr); err != nil {
+			r.Log.Error(err, "failed to create an external remediation object")
+			return nil, false, err
+		}
+		r.nodeEvent(nhc, eventTypeNormal, eventReasonRemediationCreated, "",
+			fmt.Sprintf("Created remediation object for node %s", n.Name))
+		r.recordRemediationStarted(nhc, n.GetName(), cr.GetKind())
+		metrics.ObserveNodeHealthCheckEscalationStepReached(nhc.GetName(), escalation.Order)
+		if transition := r.unhealthyTransition(nhc, n); transition != nil {
+			metrics.ObserveNodeHealthCheckRemediationCreationDelay(nhc.GetName(), cr.GetKind(), time.Since(transition.Time).Seconds())
+		}
+		requeue := r.effectiveTimeout(n, nhc, escalation.Timeout.Duration)
+		return &requeue, false, nil
+	}
+	return nil, false, nil
+}
+
+// now returns the current time from the injected clock, falling back to the
+// real clock when none was injected.
+func (r *NodeHealthCheckReconciler) now() time.Time {
+	if r.clock != nil {
+		return r.clock.Now()
+	}
+	return time.Now()
+}
+
+// remediationConditionTypes returns the condition types the controller inspects
+// on the NHC's remediation CRs, either the configured mapping or the
+// conventional medik8s names. The failed type has no conventional counterpart,
+// terminal failures default to the terminal failure condition types instead.
+func remediationConditionTypes(nhc *remediationv1alpha1.NodeHealthCheck) (succeededType, processingType, failedType string) {
+	if mapping := nhc.Spec.RemediationConditionMapping; mapping != nil {
+		return mapping.SucceededType, mapping.ProcessingType, mapping.FailedType
+	}
+	return conventionalSucceededConditionType, conventionalProcessingConditionType, ""
+}
+
+// remediationCRConditionStatus returns the status of the given condition type
+// on the remediation CR, or nil when the CR doesn't report it.
+func remediationCRConditionStatus(cr *unstructured.Unstructured, conditionType string) *metav1.ConditionStatus {
+	if conditionType == "" {
+		return nil
+	}
+	conditions, exists, err := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if err != nil || !exists {
+		return nil
+	}
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if conditionType != conditionMap["type"] {
+			continue
+		}
+		if statusValue, ok := conditionMap["status"].(string); ok {
+			status := metav1.ConditionStatus(statusValue)
+			return &status
+		}
+	}
+	return nil
+}
+
+// remediationFailedTerminally checks whether the remediator reported one of the
+// NHC's terminal failure condition types, or its mapped failed condition type,
+// with status True on the remediation CR.
+func remediationFailedTerminally(nhc *remediationv1alpha1.NodeHealthCheck, cr *unstructured.Unstructured) bool {
+	_, _, failedType := remediationConditionTypes(nhc)
+	if status := remediationCRConditionStatus(cr, failedType); status != nil && *status == metav1.ConditionTrue {
+		return true
+	}
+	for _, terminalType := range nhc.Spec.TerminalFailureConditionTypes {
+		if status := remediationCRConditionStatus(cr, terminalType); status != nil && *status == metav1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// remediationGaveUp checks whether the remediator reported that it stopped
+// processing without success: the succeeded condition is explicitly not True
+// while the processing condition is False. Escalating remediations then move
+// on to the next template without waiting for the step timeout.
+func remediationGaveUp(nhc *remediationv1alpha1.NodeHealthCheck, cr *unstructured.Unstructured) bool {
+	succeededType, processingType, _ := remediationConditionTypes(nhc)
+	succeeded := remediationCRConditionStatus(cr, succeededType)
+	if succeeded == nil || *succeeded == metav1.ConditionTrue {
+		return false
+	}
+	processing := remediationCRConditionStatus(cr, processingType)
+	return processing != nil && *processing == metav1.ConditionFalse
+}
+
+// remediationApproved checks whether the remediation of the node may proceed,
+// per the NHC's RequireApproval gate. While no approval is given, the node is
+// marked as pending approval via annotation, which also records since when the
+// approval is pending, for the auto-approve timeout.
+func (r *NodeHealthCheckReconciler) remediationApproved(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (bool, time.Duration, error) {
+	if !nhc.Spec.RequireApproval {
+		return true, 0, nil
+	}
+	if _, exists := nhc.GetAnnotations()[approvedByAnnotationKey]; exists {
+		return true, 0, nil
+	}
+	if _, exists := n.GetAnnotations()[approvedByAnnotationKey]; exists {
+		return true, 0, nil
+	}
+
+	pendingSince := time.Now()
+	markPending := true
+	if value, exists := n.GetAnnotations()[approvalPendingAnnotationKey]; exists {
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+			// ignore a leftover marker from an earlier incident
+			if transition := r.unhealthyTransition(nhc, n); transition == nil || !parsed.Before(transition.Time) {
+				pendingSince = parsed
+				markPending = false
+			}
+		}
+	}
+	if markPending && nhc.Spec.NodeResourceRef == nil {
+		node := &v1.Node{}
+		if err := r.Get(ctx, client.ObjectKey{Name: n.GetName()}, node); err != nil {
+			return false, 0, err
+		}
+		base := node.DeepCopy()
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[approvalPendingAnnotationKey] = pendingSince.UTC().Format(time.RFC3339)
+		if err := r.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+			return false, 0, errors.Wrapf(err, "failed to mark node %s as pending approval", node.GetName())
+		}
+	}
+
+	if nhc.Spec.AutoApproveTimeout != nil && time.Since(pendingSince) >= nhc.Spec.AutoApproveTimeout.Duration {
+		r.Log.Info("auto-approving remediation, no approval was given within the timeout", "nodeName", n.Name)
+		return true, 0, nil
+	}
+	return false, 1 * time.Minute, nil
+}
+
+// acquireNodeLeaseConfirmed creates or renews the lease marking the node as
+// being remediated by this NHC and confirms with a verification read that this
+// NHC actually holds it. Remediation must not start without a confirmed lease,
+// so the strictly lease-first ordering holds even when the lease write got
+// lost or another NHC selecting the same node holds a valid lease. With an
+// unknown deployment namespace the lease cannot be managed, so the remediation
+// is deferred rather than started unprotected.
+func (r *NodeHealthCheckReconciler) acquireNodeLeaseConfirmed(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) bool {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		r.Log.Error(err, "cannot manage remediation leases without a known deployment namespace, deferring remediation", "nodeName", n.GetName())
+		return false
+	}
+	r.acquireNodeLease(ctx, n, nhc)
+	lease := &coordv1.Lease{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: leaseNamePrefix + n.GetName()}, lease); err != nil {
+		r.Log.Error(err, "failed to confirm the remediation lease", "nodeName", n.GetName())
+		return false
+	}
+	holder := leaseHolderIdentityPrefix + nhc.GetName()
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != holder {
+		r.Log.Info("the node's remediation lease is held by another NHC, deferring remediation", "nodeName", n.GetName())
+		return false
+	}
+	return true
+}
+
+// remediationLeaseExpired reports whether the lease's last renewal is older
+// than its lease duration. Leases without renewal bookkeeping count as expired.
+func remediationLeaseExpired(lease *coordv1.Lease) bool {
+	if lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true
+	}
+	return time.Now().After(lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second))
+}
+
+// acquireNodeLease creates or renews the lease marking the node as being
+// remediated by this NHC. A valid lease held by another NHC is left untouched,
+// so the subsequent confirmation defers this NHC's remediation; an expired
+// lease is taken over. Callers confirm the lease before starting remediation.
+func (r *NodeHealthCheckReconciler) acquireNodeLease(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		// without a known namespace there is nowhere to put the lease
+		return
+	}
+	now := metav1.NewMicroTime(time.Now())
+	holder := leaseHolderIdentityPrefix + nhc.GetName()
+	duration := leaseDurationSeconds
+	lease := &coordv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      leaseNamePrefix + n.GetName(),
+			Namespace: ns,
+		},
+		Spec: coordv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			LeaseDurationSeconds: &duration,
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	}
+	err = r.Client.Create(ctx, lease)
+	if err == nil {
+		return
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		r.Log.Error(err, "failed to create the remediation lease", "nodeName", n.GetName())
+		return
+	}
+
+	existing := &coordv1.Lease{}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(lease), existing); err != nil {
+		r.Log.Error(err, "failed to fetch the existing remediation lease", "nodeName", n.GetName())
+		return
+	}
+	heldByUs := existing.Spec.HolderIdentity != nil && *existing.Spec.HolderIdentity == holder
+	if !heldByUs {
+		if !remediationLeaseExpired(existing) {
+			// a valid lease of another NHC, the confirmation defers the remediation
+			return
+		}
+		r.Log.Info("taking over an expired remediation lease", "nodeName", n.GetName())
+		existing.Spec.AcquireTime = &now
+	}
+	existing.Spec.HolderIdentity = &holder
+	existing.Spec.LeaseDurationSeconds = &duration
+	existing.Spec.RenewTime = &now
+	if err := r.Client.Update(ctx, existing); err != nil {
+		r.Log.Error(err, "failed to renew the remediation lease", "nodeName", n.GetName())
+	}
+}
+
+// releaseNodeLease deletes the node's remediation lease when this NHC holds
+// it. Leases of other NHCs and missing leases are left alone, so one NHC
+// marking the node healthy doesn't release another NHC's in-flight lease.
+func (r *NodeHealthCheckReconciler) releaseNodeLease(ctx context.Context, nodeName string, nhc *remediationv1alpha1.NodeHealthCheck) {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		return
+	}
+	lease := &coordv1.Lease{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: ns, Name: leaseNamePrefix + nodeName}, lease); err != nil {
+		if !apierrors.IsNotFound(err) {
+			r.Log.Error(err, "failed to release the remediation lease", "nodeName", nodeName)
+		}
+		return
+	}
+	holder := leaseHolderIdentityPrefix + nhc.GetName()
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != holder {
+		return
+	}
+	if err := r.Client.Delete(ctx, lease); err != nil && !apierrors.IsNotFound(err) {
+		r.Log.Error(err, "failed to release the remediation lease", "nodeName", nodeName)
+	}
+}
+
+// cleanupOrphanedLeases releases leases held by this NHC which correspond to
+// neither an in-flight remediation nor a currently unhealthy node, e.g. after the
+// controller crashed between lease and remediation CR creation.
+func (r *NodeHealthCheckReconciler) cleanupOrphanedLeases(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck, unhealthyNodes []v1.Node, remediations map[string]metav1.Time) error {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		return nil
+	}
+	leases := &coordv1.LeaseList{}
+	if err := r.List(ctx, leases, client.InNamespace(ns)); err != nil {
+		return errors.Wrap(err, "failed to list remediation leases")
+	}
+
+	holder := leaseHolderIdentityPrefix + nhc.GetName()
+	unhealthyNames := make(map[string]bool, len(unhealthyNodes))
+	for i := range unhealthyNodes {
+		unhealthyNames[unhealthyNodes[i].GetName()] = true
+	}
+	for i := range leases.Items {
+		lease := &leases.Items[i]
+		if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != holder {
+			continue
+		}
+		nodeName := strings.TrimPrefix(lease.GetName(), leaseNamePrefix)
+		if unhealthyNames[nodeName] {
+			continue
+		}
+		if _, inFlight := remediations[nodeName]; inFlight {
+			continue
+		}
+		r.Log.Info("releasing orphaned remediation lease", "lease", lease.GetName())
+		if err := r.Delete(ctx, lease); err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to release orphaned lease %s", lease.GetName())
+		}
+	}
+	return nil
+}
+
+// nodeHealed re-fetches the node and reports whether it is healthy by now,
+// i.e. the snapshot from the node list is stale. The fresh node goes through
+// the same composite evaluation as the main health path, so a node kept
+// unhealthy by a condition profile, annotation, taint or flapping isn't
+// declared healed prematurely.
+func (r *NodeHealthCheckReconciler) nodeHealed(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (bool, error) {
+	if nhc.Spec.NodeResourceRef != nil {
+		// node-like resources aren't re-fetched, rely on the snapshot
+		return false, nil
+	}
+	node := &v1.Node{}
+	if err := r.Get(ctx, client.ObjectKey{Name: n.GetName()}, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			// a deleted node needs no further escalation
+			return true, nil
+		}
+		return false, err
+	}
+	return r.nodeHealthy(nhc, node), nil
+}
+
+// remediationSpacingDelay returns how long the creation of the next remediation CR
+// for this NHC has to wait to honor MinDelayBetweenRemediations, or zero when it
+// may be created now, in which case the creation time is recorded.
+func (r *NodeHealthCheckReconciler) remediationSpacingDelay(nhc *remediationv1alpha1.NodeHealthCheck) time.Duration {
+	if nhc.Spec.MinDelayBetweenRemediations == nil {
+		return 0
+	}
+	r.lastRemediationCreatesLock.Lock()
+	defer r.lastRemediationCreatesLock.Unlock()
+	if r.lastRemediationCreates == nil {
+		r.lastRemediationCreates = make(map[string]time.Time)
+	}
+	if last, exists := r.lastRemediationCreates[nhc.GetName()]; exists {
+		if wait := nhc.Spec.MinDelayBetweenRemediations.Duration - time.Since(last); wait > 0 {
+			return wait
+		}
+	}
+	r.lastRemediationCreates[nhc.GetName()] = time.Now()
+	return 0
+}
+
+// effectiveTimeout applies the node's timeout-multiplier annotation to the given
+// escalation step timeout, when the NHC allows overriding it.
+func (r *NodeHealthCheckReconciler) effectiveTimeout(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck, timeout time.Duration) time.Duration {
+	if !nhc.Spec.AllowTimeoutOverride {
+		return timeout
+	}
+	value, exists := n.GetAnnotations()[timeoutMultiplierAnnotationKey]
+	if !exists {
+		return timeout
+	}
+	multiplier, err := strconv.ParseFloat(value, 64)
+	if err != nil || multiplier <= 0 {
+		r.Log.Info("ignoring invalid timeout-multiplier annotation", "nodeName", n.Name, "value", value)
+		return timeout
+	}
+	return time.Duration(float64(timeout) * multiplier)
+}
+
+// escalationStartIndex returns the index of the escalating remediation to start with.
+// A node annotation may pin the start to a specific order, when the NHC allows overriding it.
+func (r *NodeHealthCheckReconciler) escalationStartIndex(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck, escalations []remediationv1alpha1.EscalatingRemediation) int {
+	if !nhc.Spec.AllowStartOrderOverride {
+		return 0
+	}
+	value, exists := n.GetAnnotations()[startOrderAnnotationKey]
+	if !exists {
+		return 0
+	}
+	order, err := strconv.Atoi(value)
+	if err != nil {
+		r.Log.Info("ignoring invalid start-order annotation", "nodeName", n.Name, "value", value)
+		return 0
+	}
+	for i := range escalations {
+		if escalations[i].Order == order {
+			return i
 		}
+	}
+	r.Log.Info("ignoring start-order annotation, no escalating remediation with this order", "nodeName", n.Name, "order", order)
+	return 0
+}
 
-		// create CR
-		r.Log.Info("node seems unhealthy. Creating an external remediation object",
-			"nodeName", n.Name, "CR name", cr.GetName(), "CR gvk", cr.GroupVersionKind(), "ns", cr.GetNamespace())
-		if err = r.Client.Create(ctx, cr); err != nil {
-			r.Log.Error(err, "failed to create an external remediation object")
-			return nil, err
+// prerequisiteHealthGateMet evaluates the NHC's prerequisite health gate: the
+// referenced resource's condition must be True and the endpoint, when set,
+// must report healthy. Unlike the peer confirmation, failures to evaluate the
+// gate keep it unmet, erring on the side of not remediating during a broader
+// cluster incident. The returned reason is meant for events and logs.
+func (r *NodeHealthCheckReconciler) prerequisiteHealthGateMet(nhc *remediationv1alpha1.NodeHealthCheck) (bool, string) {
+	gate := nhc.Spec.PrerequisiteHealthGate
+	if gate == nil {
+		return true, ""
+	}
+
+	if ref := gate.ResourceRef; ref != nil {
+		resource := &unstructured.Unstructured{}
+		resource.SetGroupVersionKind(ref.GroupVersionKind())
+		if err := r.Get(context.Background(), client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, resource); err != nil {
+			return false, fmt.Sprintf("failed to get the gate resource %s %s/%s: %v", ref.Kind, ref.Namespace, ref.Name, err)
+		}
+		conditionType := gate.ConditionType
+		if conditionType == "" {
+			conditionType = "Ready"
+		}
+		if !unstructuredConditionTrue(resource, conditionType) {
+			return false, fmt.Sprintf("the %q condition of the gate resource %s %s/%s is not True", conditionType, ref.Kind, ref.Namespace, ref.Name)
 		}
-		r.Recorder.Event(nhc, eventTypeNormal, eventReasonRemediationCreated, fmt.Sprintf("Created remediation object for node %s", n.Name))
-		return nil, nil
 	}
 
-	// CR exists
-	isAlert, nextReconcile := r.alertOldRemediationCR(cr)
-	if isAlert {
-		metrics.ObserveNodeHealthCheckOldRemediationCR(n.Name, n.Namespace)
+	if gate.Endpoint != "" {
+		httpClient := http.Client{Timeout: 5 * time.Second}
+		resp, err := httpClient.Get(gate.Endpoint)
+		if err != nil {
+			return false, fmt.Sprintf("the gate endpoint is unreachable: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return false, fmt.Sprintf("the gate endpoint returned status %d", resp.StatusCode)
+		}
+	}
+
+	return true, ""
+}
+
+// unstructuredConditionTrue reports whether the resource carries a condition
+// of the given type with status True.
+func unstructuredConditionTrue(resource *unstructured.Unstructured, conditionType string) bool {
+	conditions, exists, err := unstructured.NestedSlice(resource.Object, "status", "conditions")
+	if err != nil || !exists {
+		return false
+	}
+	for _, condition := range conditions {
+		conditionMap, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if conditionMap["type"] == conditionType {
+			return conditionMap["status"] == string(metav1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+// peerConfirmed checks with the peer endpoint configured on the NHC, if any,
+// whether the node is unhealthy from its vantage point as well.
+func (r *NodeHealthCheckReconciler) peerConfirmed(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) bool {
+	pc := nhc.Spec.PeerConfirmation
+	if pc == nil {
+		return true
+	}
+	timeout := 5 * time.Second
+	if pc.Timeout != nil {
+		timeout = pc.Timeout.Duration
 	}
-	return nextReconcile, nil
+	return peer.NewHTTPConfirmer(pc.Endpoint, timeout, r.Log).Confirm(n.GetName())
 }
 
 func (r *NodeHealthCheckReconciler) generateRemediationCR(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*unstructured.Unstructured, error) {
-	t, err := r.fetchTemplate(nhc)
+	templateRef := templateRefForNode(n, nhc)
+	if templateRef == nil {
+		return nil, errors.Errorf("NHC %s configures no remediation method, cannot remediate node %s", nhc.GetName(), n.GetName())
+	}
+	return r.generateRemediationCRForTemplate(n, nhc, templateRef)
+}
+
+// remediationCRSpecOutdated reports whether the remediation CR was created
+// under an earlier generation of the NHC's spec, based on the generation label
+// stamped onto created CRs. CRs predating the label report false.
+func remediationCRSpecOutdated(nhc *remediationv1alpha1.NodeHealthCheck, cr *unstructured.Unstructured) bool {
+	value, exists := cr.GetLabels()[nhcGenerationLabelKey]
+	if !exists {
+		return false
+	}
+	generation, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false
+	}
+	return generation < nhc.GetGeneration()
+}
+
+// templateRefForNode returns the remediation template to use for the given node,
+// considering the NHC's node info based template mapping. Falls back to the
+// default template when no mapping matches. Returns nil when the NHC configures
+// no remediation method at all, which the webhook rejects but can't prevent,
+// e.g. with webhooks disabled or for objects predating the webhook.
+func templateRefForNode(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) *v1.ObjectReference {
+	for i := range nhc.Spec.RemediationByNodeInfo {
+		mapping := &nhc.Spec.RemediationByNodeInfo[i]
+		if mapping.OperatingSystem != "" && mapping.OperatingSystem != n.Status.NodeInfo.OperatingSystem {
+			continue
+		}
+		if mapping.Architecture != "" && mapping.Architecture != n.Status.NodeInfo.Architecture {
+			continue
+		}
+		return &mapping.RemediationTemplate
+	}
+	if refs := remediationTemplateRefs(nhc); len(refs) > 0 {
+		return refs[0]
+	}
+	return nil
+}
+
+func (r *NodeHealthCheckReconciler) generateRemediationCRForTemplate(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck, templateRef *v1.ObjectReference) (*unstructured.Unstructured, error) {
+	// with a watch namespace, remediation CRs may only live there, so their
+	// templates must reside there as well
+	if r.WatchNamespace != "" && templateRef.Namespace != r.WatchNamespace {
+		return nil, errors.Errorf("remediation template %s/%s resides outside the watched namespace %s",
+			templateRef.Namespace, templateRef.Name, r.WatchNamespace)
+	}
+	t, err := r.fetchTemplate(templateRef)
 	if err != nil {
 		return nil, err
 	}
@@ -359,29 +3984,268 @@ func (r *NodeHealthCheckReconciler) generateRemediationCR(n *v1.Node, nhc *remed
 		Version: t.GroupVersionKind().Version,
 		Kind:    strings.TrimSuffix(t.GetKind(), templateSuffix),
 	})
-	u.SetOwnerReferences([]metav1.OwnerReference{
-		{
-			APIVersion:         nhc.APIVersion,
-			Kind:               nhc.Kind,
-			Name:               nhc.Name,
-			UID:                nhc.UID,
-			Controller:         pointer.BoolPtr(false),
-			BlockOwnerDeletion: nil,
-		},
-	})
+	controller := pointer.BoolPtr(false)
+	var blockOwnerDeletion *bool
+	if ownerRef := nhc.Spec.RemediationOwnerReference; ownerRef != nil {
+		if ownerRef.Controller != nil {
+			controller = ownerRef.Controller
+		}
+		blockOwnerDeletion = ownerRef.BlockOwnerDeletion
+	}
+	owner := metav1.OwnerReference{
+		APIVersion: nhc.APIVersion,
+		Kind:       nhc.Kind,
+		Name:       nhc.Name,
+		UID:        nhc.UID,
+	}
+	if resolved, err := r.resolveRemediationOwner(n, nhc); err != nil {
+		return nil, err
+	} else if resolved != nil {
+		owner = *resolved
+	}
+	owner.Controller = controller
+	owner.BlockOwnerDeletion = blockOwnerDeletion
+	u.SetOwnerReferences([]metav1.OwnerReference{owner})
 	u.SetLabels(map[string]string{
 		"app.kubernetes.io/part-of": "node-healthcheck-controller",
+		// correlates the CR with the exact spec generation that produced it
+		nhcGenerationLabelKey: strconv.FormatInt(nhc.GetGeneration(), 10),
 	})
+	if key := r.dedupKey(nhc, n); key != "" {
+		u.SetAnnotations(map[string]string{
+			dedupKeyAnnotationKey: key,
+		})
+	}
 	u.SetResourceVersion("")
 	u.SetFinalizers(nil)
+	if nhc.Spec.ProtectInFlightRemediations {
+		// prevent owner-reference GC from aborting an active remediation when the NHC is deleted
+		u.SetFinalizers([]string{protectInFlightFinalizer})
+	}
 	u.SetUID("")
 	u.SetSelfLink("")
-	u.SetCreationTimestamp(metav1.Now())
+	u.SetCreationTimestamp(metav1.Time{Time: r.now()})
 	return &u, nil
 }
 
-func (r *NodeHealthCheckReconciler) fetchTemplate(nhc *remediationv1alpha1.NodeHealthCheck) (*unstructured.Unstructured, error) {
-	t := nhc.Spec.RemediationTemplate.DeepCopy()
+// resolveRemediationOwner resolves the owner for created remediation CRs per
+// the NHC's RemediationOwner setting: the Machine backing the unhealthy node,
+// or the MachineSet owning that Machine. Returns nil when the NHC itself should
+// own the CRs, which is also the graceful fallback when the node has no backing
+// Machine, e.g. on non-OpenShift clusters.
+func (r *NodeHealthCheckReconciler) resolveRemediationOwner(n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*metav1.OwnerReference, error) {
+	owner := nhc.Spec.RemediationOwner
+	if owner == "" || owner == remediationv1alpha1.RemediationOwnerNHC {
+		return nil, nil
+	}
+
+	machine, err := r.fetchMachine(context.Background(), n, nhc)
+	if err != nil {
+		return nil, err
+	}
+	if machine == nil {
+		r.Log.Info("node has no backing machine, falling back to the NHC as remediation CR owner", "nodeName", n.Name)
+		return nil, nil
+	}
+
+	if owner == remediationv1alpha1.RemediationOwnerMachine {
+		return &metav1.OwnerReference{
+			APIVersion: machine.GetAPIVersion(),
+			Kind:       machine.GetKind(),
+			Name:       machine.GetName(),
+			UID:        machine.GetUID(),
+		}, nil
+	}
+
+	// resolve the MachineSet through the machine's owner chain
+	for _, ref := range machine.GetOwnerReferences() {
+		if ref.Kind == remediationv1alpha1.RemediationOwnerMachineSet {
+			return &metav1.OwnerReference{
+				APIVersion: ref.APIVersion,
+				Kind:       ref.Kind,
+				Name:       ref.Name,
+				UID:        ref.UID,
+			}, nil
+		}
+	}
+	r.Log.Info("the node's machine has no MachineSet owner, falling back to the NHC as remediation CR owner",
+		"nodeName", n.Name, "machine", machine.GetName())
+	return nil, nil
+}
+
+// fetchMachine fetches the Machine backing the node, resolving the node to
+// machine mapping via the OpenShift machine annotation or, with a custom
+// machine API group configured, via the Cluster API annotation conventions.
+// Returns nil without error when the node has no backing machine.
+func (r *NodeHealthCheckReconciler) fetchMachine(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (*unstructured.Unstructured, error) {
+	group := nhc.Spec.MachineAPIGroup
+	if group == "" {
+		group = machinev1beta1.GroupVersion.Group
+	}
+	kind := nhc.Spec.MachineKind
+	if kind == "" {
+		kind = "Machine"
+	}
+
+	var namespace, name string
+	if group == machinev1beta1.GroupVersion.Group {
+		machineKey, annotated := n.GetAnnotations()[machineAnnotationKey]
+		parts := strings.Split(machineKey, "/")
+		if !annotated || len(parts) != 2 {
+			return nil, nil
+		}
+		namespace, name = parts[0], parts[1]
+	} else {
+		// Cluster API convention: the machine name and its namespace are
+		// carried in separate annotations
+		name = n.GetAnnotations()[capiMachineAnnotationKey]
+		namespace = n.GetAnnotations()[capiMachineNsAnnotationKey]
+		if name == "" || namespace == "" {
+			return nil, nil
+		}
+	}
+
+	machine := &unstructured.Unstructured{}
+	// both the OpenShift machine API and current Cluster API serve v1beta1
+	machine.SetGroupVersionKind(schema.GroupVersionKind{Group: group, Version: "v1beta1", Kind: kind})
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, machine); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to fetch the machine of node %s", n.GetName())
+	}
+	return machine, nil
+}
+
+// machineBeingDeleted reports whether the node's backing Machine is already
+// being deleted, e.g. by a MachineSet scale down or the cluster autoscaler.
+// Only checked with a machine based remediation owner; remediating such a node
+// is redundant and may conflict with the ongoing deletion.
+func (r *NodeHealthCheckReconciler) machineBeingDeleted(ctx context.Context, n *v1.Node, nhc *remediationv1alpha1.NodeHealthCheck) (bool, error) {
+	if owner := nhc.Spec.RemediationOwner; owner != remediationv1alpha1.RemediationOwnerMachine && owner != remediationv1alpha1.RemediationOwnerMachineSet {
+		return false, nil
+	}
+
+	machine, err := r.fetchMachine(ctx, n, nhc)
+	if err != nil {
+		return false, err
+	}
+	return machine != nil && machine.GetDeletionTimestamp() != nil, nil
+}
+
+// remediationTemplateRefs returns the remediation template references the NHC works with,
+// ordered by escalation order when escalating remediations are configured, followed by
+// the node info based template mappings.
+func remediationTemplateRefs(nhc *remediationv1alpha1.NodeHealthCheck) []*v1.ObjectReference {
+	var refs []*v1.ObjectReference
+	if len(nhc.Spec.EscalatingRemediations) == 0 {
+		// with job based remediation there may be no template at all
+		if nhc.Spec.RemediationTemplate != nil {
+			refs = []*v1.ObjectReference{nhc.Spec.RemediationTemplate}
+		}
+	} else {
+		escalations := escalationsInOrder(nhc)
+		refs = make([]*v1.ObjectReference, 0, len(escalations))
+		for i := range escalations {
+			refs = append(refs, &escalations[i].RemediationTemplate)
+		}
+	}
+	for i := range nhc.Spec.RemediationByNodeInfo {
+		refs = append(refs, &nhc.Spec.RemediationByNodeInfo[i].RemediationTemplate)
+	}
+	return refs
+}
+
+// trackedTemplateRefs returns the NHC's current template references plus those recorded
+// in its status, so in-flight remediations created from a template which was changed
+// afterwards don't get orphaned.
+func trackedTemplateRefs(nhc *remediationv1alpha1.NodeHealthCheck) []*v1.ObjectReference {
+	key := func(ref *v1.ObjectReference) string {
+		return fmt.Sprintf("%s/%s/%s/%s", ref.APIVersion, ref.Kind, ref.Namespace, ref.Name)
+	}
+	refs := remediationTemplateRefs(nhc)
+	seen := make(map[string]bool, len(refs))
+	for _, ref := range refs {
+		seen[key(ref)] = true
+	}
+	for i := range nhc.Status.TemplatesInUse {
+		if ref := &nhc.Status.TemplatesInUse[i]; !seen[key(ref)] {
+			refs = append(refs, ref)
+			seen[key(ref)] = true
+		}
+	}
+	return refs
+}
+
+// cleanupStaleRemediationCRs deletes the in-flight remediation CRs which were
+// created from a template the spec no longer references. The nodes they belong
+// to are re-evaluated in the same reconcile and, when still unhealthy, get a
+// fresh remediation from the start of the new spec's remediation chain.
+func (r *NodeHealthCheckReconciler) cleanupStaleRemediationCRs(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck) error {
+	key := func(ref *v1.ObjectReference) string {
+		return fmt.Sprintf("%s/%s/%s/%s", ref.APIVersion, ref.Kind, ref.Namespace, ref.Name)
+	}
+	current := make(map[string]bool)
+	for _, ref := range remediationTemplateRefs(nhc) {
+		current[key(ref)] = true
+	}
+	for _, templateRef := range trackedTemplateRefs(nhc) {
+		if current[key(templateRef)] {
+			continue
+		}
+		for nodeName := range nhc.Status.InFlightRemediations {
+			cr := remediationCRShim(templateRef, nodeName)
+			if err := r.Client.Get(ctx, client.ObjectKeyFromObject(cr), cr); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+			if cr.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if err := r.removeProtectionFinalizer(ctx, cr); err != nil {
+				return err
+			}
+			if err := r.Client.Delete(ctx, cr); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			r.Log.Info("deleted a remediation object whose template the spec no longer references",
+				"nodeName", nodeName, "CR gvk", cr.GroupVersionKind())
+			r.nodeEvent(nhc, eventTypeNormal, eventReasonRemediationRemoved, "",
+				fmt.Sprintf("Deleted the remediation object for node %s, its template is no longer referenced by the spec", nodeName))
+		}
+	}
+	return nil
+}
+
+// remediationCRShim returns an empty remediation CR with GVK, namespace and name derived
+// from the template reference only, without fetching the template. This keeps remediation
+// CRs listable and deletable even after their template was changed or deleted.
+func remediationCRShim(templateRef *v1.ObjectReference, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   templateRef.GroupVersionKind().Group,
+		Version: templateRef.GroupVersionKind().Version,
+		Kind:    strings.TrimSuffix(templateRef.Kind, templateSuffix),
+	})
+	u.SetNamespace(templateRef.Namespace)
+	u.SetName(name)
+	return u
+}
+
+// escalationsInOrder returns the NHC's escalating remediations sorted by their order.
+func escalationsInOrder(nhc *remediationv1alpha1.NodeHealthCheck) []remediationv1alpha1.EscalatingRemediation {
+	escalations := make([]remediationv1alpha1.EscalatingRemediation, len(nhc.Spec.EscalatingRemediations))
+	copy(escalations, nhc.Spec.EscalatingRemediations)
+	sort.Slice(escalations, func(i, j int) bool {
+		return escalations[i].Order < escalations[j].Order
+	})
+	return escalations
+}
+
+func (r *NodeHealthCheckReconciler) fetchTemplate(templateRef *v1.ObjectReference) (*unstructured.Unstructured, error) {
+	t := templateRef.DeepCopy()
 	obj := new(unstructured.Unstructured)
 	obj.SetAPIVersion(t.APIVersion)
 	obj.SetGroupVersionKind(t.GroupVersionKind())
@@ -393,64 +4257,435 @@ func (r *NodeHealthCheckReconciler) fetchTemplate(nhc *remediationv1alpha1.NodeH
 	return obj, nil
 }
 
-func (r *NodeHealthCheckReconciler) patchStatus(nhc *remediationv1alpha1.NodeHealthCheck, observedNodes int, unhealthyNodes int, remediations map[string]metav1.Time) error {
+// mirrorStatusToNodes mirrors per-node remediation state onto the nodes as labels and
+// annotations. Writes are idempotent, nodes are only patched when the state changed.
+func (r *NodeHealthCheckReconciler) mirrorStatusToNodes(ctx context.Context, nodes []v1.Node, remediations map[string]metav1.Time) error {
+	for i := range nodes {
+		node := &nodes[i]
+		base := node.DeepCopy()
+
+		remediationTime, underRemediation := remediations[node.GetName()]
+		changed := false
+		if underRemediation {
+			if node.Labels[underRemediationLabelKey] != "true" {
+				if node.Labels == nil {
+					node.Labels = map[string]string{}
+				}
+				node.Labels[underRemediationLabelKey] = "true"
+				changed = true
+			}
+			if timestamp := remediationTime.UTC().Format(time.RFC3339); node.Annotations[lastRemediationAnnotationKey] != timestamp {
+				if node.Annotations == nil {
+					node.Annotations = map[string]string{}
+				}
+				node.Annotations[lastRemediationAnnotationKey] = timestamp
+				changed = true
+			}
+		} else if _, exists := node.Labels[underRemediationLabelKey]; exists {
+			// clear the label, the last-remediation annotation is kept for reference
+			delete(node.Labels, underRemediationLabelKey)
+			changed = true
+		}
+
+		if !changed {
+			continue
+		}
+		if err := r.Client.Patch(ctx, node, client.MergeFrom(base)); err != nil {
+			return errors.Wrapf(err, "failed to mirror remediation state to node %s", node.GetName())
+		}
+	}
+	return nil
+}
 
-	healthyNodes := observedNodes - unhealthyNodes
+// updateQuorumBlockedCondition surfaces the deadlock of an NHC whose selector
+// matches only control-plane nodes while all of them are unhealthy: remediating
+// any of them would break quorum, so the MinHealthy gate blocks remediation
+// indefinitely. The condition tells the operator how to get out of the deadlock
+// instead of leaving the NHC silently idle. It is set to False again once at
+// least one selected node is healthy or a non-control-plane node is selected.
+func (r *NodeHealthCheckReconciler) updateQuorumBlockedCondition(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck, nodes []v1.Node, unhealthyNodes []v1.Node) error {
+	blocked := len(nodes) > 0 && len(unhealthyNodes) == len(nodes) && !nhc.Spec.AllowRemediationWhenAllUnhealthy
+	for i := range nodes {
+		if !blocked {
+			break
+		}
+		if !utils.IsControlPlane(nodes[i].GetLabels()) {
+			blocked = false
+		}
+	}
 
-	// skip when no changes
-	if nhc.Status.ObservedNodes == observedNodes &&
-		nhc.Status.HealthyNodes == healthyNodes &&
-		((len(nhc.Status.InFlightRemediations) == 0 && len(remediations) == 0) || reflect.DeepEqual(nhc.Status.InFlightRemediations, remediations)) {
-		return nil
+	condition := meta.FindStatusCondition(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeQuorumBlocked)
+	if blocked && (condition == nil || condition.Status != metav1.ConditionTrue) {
+		message := fmt.Sprintf("All %d selected nodes are unhealthy control-plane nodes, remediating any of them would break quorum. "+
+			"Recover at least one control-plane node manually, or set allowRemediationWhenAllUnhealthy to remediate regardless", len(nodes))
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeQuorumBlocked,
+			Status:  metav1.ConditionTrue,
+			Reason:  remediationv1alpha1.ConditionReasonAllControlPlaneUnhealthy,
+			Message: message,
+		})
+		r.Recorder.Event(nhc, eventTypeWarning, eventReasonQuorumBlocked, message)
+		return r.updateStatusConditions(ctx, nhc)
+	}
+	if !blocked && condition != nil && condition.Status == metav1.ConditionTrue {
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeQuorumBlocked,
+			Status:  metav1.ConditionFalse,
+			Reason:  remediationv1alpha1.ConditionReasonQuorumNotBlocked,
+			Message: "Remediation is not blocked by control-plane quorum",
+		})
+		return r.updateStatusConditions(ctx, nhc)
 	}
+	return nil
+}
+
+// updateStatusConditions persists the NHC's status, retrying conflicts with
+// concurrent status writers by re-fetching the object and re-applying the
+// in-memory conditions, so a conflict neither fails the reconcile nor loses
+// the conditions set earlier in it.
+func (r *NodeHealthCheckReconciler) updateStatusConditions(ctx context.Context, nhc *remediationv1alpha1.NodeHealthCheck) error {
+	err := r.Client.Status().Update(ctx, nhc)
+	if err == nil || !apierrors.IsConflict(err) {
+		return err
+	}
+	conditions := nhc.Status.Conditions
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		fresh := &remediationv1alpha1.NodeHealthCheck{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(nhc), fresh); err != nil {
+			return err
+		}
+		// keep the in-memory spec, e.g. the resolved inheritance, overlay the
+		// fresh status and re-apply the condition changes of this reconcile
+		nhc.Status = fresh.Status
+		nhc.Status.Conditions = conditions
+		nhc.SetResourceVersion(fresh.GetResourceVersion())
+		return r.Client.Status().Update(ctx, nhc)
+	})
+}
+
+func (r *NodeHealthCheckReconciler) patchStatus(nhc *remediationv1alpha1.NodeHealthCheck, observedNodes int, unhealthyNodes []v1.Node, remediations map[string]metav1.Time, templatesInUse []v1.ObjectReference, failedNodes []string, machineDeletionNodes []string, nodesUnderVerification []string, excludedControlPlaneNodes []string) error {
+
+	healthyNodes := observedNodes - len(unhealthyNodes)
 
 	base := nhc.DeepCopy()
 	mergeFrom := client.MergeFrom(base)
 
 	nhc.Status.ObservedNodes = observedNodes
 	nhc.Status.HealthyNodes = healthyNodes
-	nhc.Status.InFlightRemediations = remediations
+	nhc.Status.ObservedGeneration = nhc.Generation
+	if len(nhc.Status.InFlightRemediations) > 0 || len(remediations) > 0 {
+		nhc.Status.InFlightRemediations = remediations
+		remediationNames := make([]string, 0, len(remediations))
+		for nodeName := range remediations {
+			remediationNames = append(remediationNames, nodeName)
+		}
+		sort.Strings(remediationNames)
+		nhc.Status.InFlightRemediationNames = remediationNames
+	}
+	if len(nhc.Status.TemplatesInUse) > 0 || len(templatesInUse) > 0 {
+		nhc.Status.TemplatesInUse = templatesInUse
+	}
+
+	// surface per-unhealthy-node state, including the remediation lease
+	deferredByMachineDeletion := make(map[string]bool, len(machineDeletionNodes))
+	for _, name := range machineDeletionNodes {
+		deferredByMachineDeletion[name] = true
+	}
+	kubeletOutdated := r.kubeletOutdatedNodes(nhc)
+	var unhealthyNodeStatuses []remediationv1alpha1.UnhealthyNode
+	for i := range unhealthyNodes {
+		unhealthyNode := remediationv1alpha1.UnhealthyNode{
+			Name:         unhealthyNodes[i].GetName(),
+			Lease:        r.nodeLeaseStatus(unhealthyNodes[i].GetName()),
+			DrainedPods:  r.drainedPodCount(unhealthyNodes[i].GetName(), nhc),
+			Remediations: r.nodeDryRunRemediations(unhealthyNodes[i].GetName(), nhc),
+		}
+		if deferredByMachineDeletion[unhealthyNode.Name] {
+			unhealthyNode.Reason = "machine deletion in progress"
+		} else if kubeletOutdated[unhealthyNode.Name] {
+			unhealthyNode.Reason = "kubelet version below the minimum"
+		} else if nhc.Spec.RemediateOnKubeletCertExpiry && kubeletCertExpired(&unhealthyNodes[i]) {
+			unhealthyNode.Reason = "kubelet certificate expired"
+		}
+		unhealthyNodeStatuses = append(unhealthyNodeStatuses, unhealthyNode)
+	}
+	nhc.Status.UnhealthyNodes = unhealthyNodeStatuses
+	nhc.Status.NodesUnderVerification = nodesUnderVerification
+	nhc.Status.ExcludedControlPlaneNodes = excludedControlPlaneNodes
+
+	var oldestAge *metav1.Duration
+	for _, remediationTime := range remediations {
+		if age := time.Since(remediationTime.Time).Round(time.Second); oldestAge == nil || age > oldestAge.Duration {
+			oldestAge = &metav1.Duration{Duration: age}
+		}
+	}
+	nhc.Status.OldestRemediationAge = oldestAge
+	ageSeconds := float64(0)
+	if oldestAge != nil {
+		ageSeconds = oldestAge.Duration.Seconds()
+	}
+	metrics.SetNodeHealthCheckOldestRemediationAge(nhc.GetName(), ageSeconds)
+
+	// the success rate only counts completions observed in memory, so after a
+	// restart it reports unknown instead of a misleading 0%, and the metric
+	// sample is removed instead of set to zero
+	recovered, total := r.remediationSuccessCounts(nhc)
+	if total == 0 {
+		nhc.Status.RemediationSuccessRate = "unknown"
+		metrics.DeleteNodeHealthCheckRemediationSuccessRatio(nhc.GetName())
+	} else {
+		nhc.Status.RemediationSuccessRate = fmt.Sprintf("%d%% (%d/%d)", recovered*100/total, recovered, total)
+		metrics.SetNodeHealthCheckRemediationSuccessRatio(nhc.GetName(), float64(recovered)/float64(total))
+	}
+
+	// merge the remediator stats of incidents completed since the last patch
+	for remediator, pending := range r.popPendingRemediatorStats(nhc.GetName()) {
+		if nhc.Status.RemediatorStats == nil {
+			nhc.Status.RemediatorStats = map[string]remediationv1alpha1.RemediatorStat{}
+		}
+		stat := nhc.Status.RemediatorStats[remediator]
+		stat.Succeeded += pending.Succeeded
+		stat.Failed += pending.Failed
+		nhc.Status.RemediatorStats[remediator] = stat
+	}
+
+	// merge the remediation history entries recorded since the last patch and
+	// prune the completed ones to the configured bounds
+	starts, completions := r.popPendingHistory(nhc.GetName())
+	history := append(nhc.Status.RemediationHistory, starts...)
+	for _, completion := range completions {
+		// complete the newest open entry of the node and remediator
+		for i := len(history) - 1; i >= 0; i-- {
+			if history[i].CompletedAt == nil && history[i].Node == completion.Node && history[i].Remediator == completion.Remediator {
+				history[i].CompletedAt = completion.CompletedAt
+				history[i].Outcome = completion.Outcome
+				break
+			}
+		}
+	}
+	// close entries whose remediation disappeared without a recorded
+	// completion, e.g. a CR deleted by the stale template cleanup or by hand,
+	// so they don't count as in-flight forever
+	for i := range history {
+		if history[i].CompletedAt == nil {
+			if _, inFlight := remediations[history[i].Node]; !inFlight {
+				now := metav1.Now()
+				history[i].CompletedAt = &now
+				history[i].Outcome = "removed"
+			}
+		}
+	}
+	nhc.Status.RemediationHistory = pruneRemediationHistory(history, nhc)
+
+	// track which campaign target nodes have been remediated at least once, and
+	// disable the NHC once the whole campaign completed
+	if len(nhc.Spec.TargetNodes) > 0 {
+		remediated := make(map[string]bool, len(nhc.Status.RemediatedTargetNodes))
+		for _, name := range nhc.Status.RemediatedTargetNodes {
+			remediated[name] = true
+		}
+		for _, name := range nhc.Spec.TargetNodes {
+			if _, exists := remediations[name]; exists && !remediated[name] {
+				nhc.Status.RemediatedTargetNodes = append(nhc.Status.RemediatedTargetNodes, name)
+				remediated[name] = true
+			}
+		}
+		if !targetNodesRemaining(nhc) && len(remediations) == 0 {
+			meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+				Type:    remediationv1alpha1.ConditionTypeDisabled,
+				Status:  metav1.ConditionTrue,
+				Reason:  remediationv1alpha1.ConditionReasonDisabledTargetNodesRemediated,
+				Message: "All target nodes have been remediated at least once, disabling the NHC",
+			})
+			r.Recorder.Eventf(nhc, eventTypeNormal, eventReasonDisabled, "All target nodes have been remediated at least once, disabling the NHC")
+		}
+	}
+
+	// set a single positive-health condition which dashboards and `kubectl wait` can target
+	if len(unhealthyNodes) == 0 && len(remediations) == 0 {
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeAllNodesHealthy,
+			Status:  metav1.ConditionTrue,
+			Reason:  remediationv1alpha1.ConditionReasonAllNodesHealthy,
+			Message: "All nodes selected by the NHC selector are healthy",
+		})
+	} else if nhc.Spec.AllowRemediationWhenAllUnhealthy && observedNodes > 0 && len(unhealthyNodes) == observedNodes {
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeAllNodesHealthy,
+			Status:  metav1.ConditionFalse,
+			Reason:  remediationv1alpha1.ConditionReasonAllNodesUnhealthy,
+			Message: fmt.Sprintf("All %d selected nodes are unhealthy, remediating despite the violated MinHealthy gate", observedNodes),
+		})
+	} else {
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeAllNodesHealthy,
+			Status:  metav1.ConditionFalse,
+			Reason:  remediationv1alpha1.ConditionReasonUnhealthyNodesFound,
+			Message: fmt.Sprintf("%d unhealthy nodes found, %d remediations in flight", len(unhealthyNodes), len(remediations)),
+		})
+	}
+
+	// track since when pause requests are in place, for the max pause duration guardrail
+	if len(nhc.Spec.PauseRequests) > 0 {
+		if nhc.Status.PausedSince == nil {
+			now := metav1.Now()
+			nhc.Status.PausedSince = &now
+		}
+	} else {
+		nhc.Status.PausedSince = nil
+	}
+
+	// surface terminal remediation failures reported by remediators
+	if len(failedNodes) > 0 {
+		sort.Strings(failedNodes)
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeRemediationFailed,
+			Status:  metav1.ConditionTrue,
+			Reason:  remediationv1alpha1.ConditionReasonTerminalFailure,
+			Message: fmt.Sprintf("Remediators reported terminal failure for nodes: %s", strings.Join(failedNodes, ", ")),
+		})
+	} else if meta.FindStatusCondition(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeRemediationFailed) != nil {
+		meta.SetStatusCondition(&nhc.Status.Conditions, metav1.Condition{
+			Type:    remediationv1alpha1.ConditionTypeRemediationFailed,
+			Status:  metav1.ConditionFalse,
+			Reason:  remediationv1alpha1.ConditionReasonNoTerminalFailure,
+			Message: "No terminal failure reported",
+		})
+	}
+
+	// derive the coarse single-value phase for dashboards and list output; the
+	// conditions carry the detailed state
+	switch {
+	case meta.IsStatusConditionTrue(nhc.Status.Conditions, remediationv1alpha1.ConditionTypeDisabled):
+		nhc.Status.Phase = remediationv1alpha1.PhaseDisabled
+	case nhc.Spec.DryRun:
+		nhc.Status.Phase = remediationv1alpha1.PhaseDryRun
+	case len(nhc.Spec.PauseRequests) > 0:
+		nhc.Status.Phase = remediationv1alpha1.PhasePaused
+	case len(remediations) > 0:
+		nhc.Status.Phase = remediationv1alpha1.PhaseRemediating
+	default:
+		nhc.Status.Phase = remediationv1alpha1.PhaseEnabled
+	}
+
+	// skip when no changes, considering conditions as well, so condition updates
+	// are persisted even when the counts don't change
+	if reflect.DeepEqual(base.Status, nhc.Status) {
+		return nil
+	}
 
 	// all values to be patched expected to be updated on the current nhc.status
 	r.Log.Info("Patching NHC object", "patch", nhc.Status)
 	return r.Client.Status().Patch(context.Background(), nhc, mergeFrom, &client.PatchOptions{})
 }
 
-func (r *NodeHealthCheckReconciler) getInflightRemediations(nhc *remediationv1alpha1.NodeHealthCheck) (map[string]metav1.Time, error) {
-	cr, err := r.generateRemediationCR(&v1.Node{}, nhc)
-	if err != nil {
-		return nil, err
-	}
-	crList := &unstructured.UnstructuredList{Object: cr.Object}
-	err = r.Client.List(context.Background(), crList)
-
-	if err != nil && !apierrors.IsNotFound(err) {
-		return nil,
-			errors.Wrapf(err, "failed to fetch all remediation objects from kind %s and apiVersion %s",
-				cr.GroupVersionKind(),
-				cr.GetAPIVersion())
+func (r *NodeHealthCheckReconciler) getInflightRemediations(nhc *remediationv1alpha1.NodeHealthCheck, nodes []v1.Node) (map[string]metav1.Time, []v1.ObjectReference, error) {
+	// compute the expected dedup keys for matching CRs which lost their owner reference,
+	// e.g. when the NHC was deleted and re-created during an in-flight remediation
+	dedupKeys := make(map[string]string, len(nodes))
+	for i := range nodes {
+		if key := r.dedupKey(nhc, &nodes[i]); key != "" {
+			dedupKeys[key] = nodes[i].GetName()
+		}
 	}
 
 	remediations := make(map[string]metav1.Time)
-	for _, remediationCR := range crList.Items {
-		for _, ownerRefs := range remediationCR.GetOwnerReferences() {
-			if ownerRefs.Name == nhc.Name &&
-				ownerRefs.Kind == nhc.Kind &&
-				ownerRefs.APIVersion == nhc.APIVersion {
+	var templatesInUse []v1.ObjectReference
+	for _, templateRef := range trackedTemplateRefs(nhc) {
+		cr := remediationCRShim(templateRef, "")
+		crList := &unstructured.UnstructuredList{Object: cr.Object}
+		var listOpts []client.ListOption
+		if r.WatchNamespace != "" {
+			listOpts = append(listOpts, client.InNamespace(r.WatchNamespace))
+		}
+		err := r.Client.List(context.Background(), crList, listOpts...)
+
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, nil,
+				errors.Wrapf(err, "failed to fetch all remediation objects from kind %s and apiVersion %s",
+					cr.GroupVersionKind(),
+					cr.GetAPIVersion())
+		}
+
+		matched := false
+		for _, remediationCR := range crList.Items {
+			if _, matches := dedupKeys[remediationCR.GetAnnotations()[dedupKeyAnnotationKey]]; matches {
 				remediations[remediationCR.GetName()] = remediationCR.GetCreationTimestamp()
+				matched = true
 				continue
 			}
+			for _, ownerRefs := range remediationCR.GetOwnerReferences() {
+				if ownerRefs.Name == nhc.Name &&
+					ownerRefs.Kind == nhc.Kind &&
+					ownerRefs.APIVersion == nhc.APIVersion {
+					remediations[remediationCR.GetName()] = remediationCR.GetCreationTimestamp()
+					matched = true
+					continue
+				}
+			}
+		}
+		if matched {
+			templatesInUse = append(templatesInUse, *templateRef)
+		}
+	}
+
+	if nhc.Spec.RemediationJobTemplate != nil {
+		if err := r.addInflightRemediationJobs(nhc, dedupKeys, remediations); err != nil {
+			return nil, nil, err
+		}
+	}
+	return remediations, templatesInUse, nil
+}
+
+// addInflightRemediationJobs adds the NHC's running remediation jobs to the
+// remediations map. Jobs are named after their node, like remediation CRs are.
+func (r *NodeHealthCheckReconciler) addInflightRemediationJobs(nhc *remediationv1alpha1.NodeHealthCheck, dedupKeys map[string]string, remediations map[string]metav1.Time) error {
+	ns, err := utils.GetDeploymentNamespace()
+	if err != nil {
+		return errors.Wrap(err, "failed to determine the namespace of the remediation jobs")
+	}
+	jobList := &batchv1.JobList{}
+	if err := r.Client.List(context.Background(), jobList, client.InNamespace(ns),
+		client.MatchingLabels{"app.kubernetes.io/part-of": "node-healthcheck-controller"}); err != nil {
+		return errors.Wrap(err, "failed to fetch the remediation jobs")
+	}
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if _, matches := dedupKeys[job.GetAnnotations()[dedupKeyAnnotationKey]]; matches {
+			remediations[job.GetName()] = job.GetCreationTimestamp()
+			continue
+		}
+		for _, ownerRef := range job.GetOwnerReferences() {
+			if ownerRef.Name == nhc.Name &&
+				ownerRef.Kind == nhc.Kind &&
+				ownerRef.APIVersion == nhc.APIVersion {
+				remediations[job.GetName()] = job.GetCreationTimestamp()
+			}
 		}
 	}
-	return remediations, nil
+	return nil
+}
+
+// crAlertTimeout returns after how long an in-flight remediation CR counts as
+// old, honoring the NHC's configured timeout and falling back to the 48h default.
+func crAlertTimeout(nhc *remediationv1alpha1.NodeHealthCheck) time.Duration {
+	if nhc.Spec.RemediationCRAlertTimeout != nil {
+		return nhc.Spec.RemediationCRAlertTimeout.Duration
+	}
+	return remediationCRAlertTimeout
 }
 
-func (r *NodeHealthCheckReconciler) alertOldRemediationCR(remediationCR *unstructured.Unstructured) (bool, *time.Duration) {
+func (r *NodeHealthCheckReconciler) alertOldRemediationCR(nhc *remediationv1alpha1.NodeHealthCheck, remediationCR *unstructured.Unstructured) (bool, *time.Duration) {
 	isSendAlert := false
 	var nextReconcile *time.Duration = nil
 	//verify remediationCR is old
-	now := time.Now()
-	if now.After(remediationCR.GetCreationTimestamp().Add(remediationCRAlertTimeout)) {
+	now := r.now()
+	if now.After(remediationCR.GetCreationTimestamp().Add(crAlertTimeout(nhc))) {
+		if nhc.Spec.DisableStuckRemediationAlert {
+			// only fire the metric, the annotation would trigger unwanted
+			// reconciles of remediators reacting on any CR change
+			return true, nextReconcile
+		}
 		var remediationCrAnnotations map[string]string
 		if remediationCrAnnotations = remediationCR.GetAnnotations(); remediationCrAnnotations == nil {
 			remediationCrAnnotations = map[string]string{}
@@ -467,14 +4702,33 @@ func (r *NodeHealthCheckReconciler) alertOldRemediationCR(remediationCR *unstruc
 
 		}
 	} else {
-		calcNextReconcile := remediationCRAlertTimeout - now.Sub(remediationCR.GetCreationTimestamp().Time) + time.Minute
+		calcNextReconcile := crAlertTimeout(nhc) - now.Sub(remediationCR.GetCreationTimestamp().Time) + time.Minute
 		nextReconcile = &calcNextReconcile
 	}
 	return isSendAlert, nextReconcile
 
 }
 
+// MaxRequeueInterval caps the requeue intervals computed during reconciles,
+// so the controller always re-checks an NHC within a bounded interval even
+// when e.g. alertOldRemediationCR computes a requeue close to 48h. It is set
+// from an operator flag.
+var MaxRequeueInterval = 10 * time.Minute
+
+// deferredRequeueInterval returns how soon nodes whose remediation was
+// deferred for a retryable reason are re-evaluated, honoring the NHC's
+// configured interval where a quickly recovering pool wants a tighter one.
+func deferredRequeueInterval(nhc *remediationv1alpha1.NodeHealthCheck) time.Duration {
+	if nhc.Spec.DeferredRequeueInterval != nil {
+		return nhc.Spec.DeferredRequeueInterval.Duration
+	}
+	return 1 * time.Minute
+}
+
 func updateResultNextReconcile(result *ctrl.Result, updatedRequeueAfter time.Duration) {
+	if MaxRequeueInterval > 0 && updatedRequeueAfter > MaxRequeueInterval {
+		updatedRequeueAfter = MaxRequeueInterval
+	}
 	if result.RequeueAfter == 0 || updatedRequeueAfter < result.RequeueAfter {
 		result.RequeueAfter = updatedRequeueAfter
 	}
@@ -2,7 +2,11 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"strings"
 	"time"
 
@@ -11,9 +15,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	coordv1 "k8s.io/api/coordination/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -174,6 +181,16 @@ var _ = Describe("Node Health Check CR", func() {
 			objects = append(objects, underTest, remediationTemplate)
 		}
 
+		// remediation is strictly lease-first and defers without a known
+		// deployment namespace, so every reconcile needs one
+		BeforeEach(func() {
+			Expect(os.Setenv("DEPLOYMENT_NAMESPACE", "default")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("DEPLOYMENT_NAMESPACE")).To(Succeed())
+		})
+
 		JustBeforeEach(func() {
 			client := fake.NewClientBuilder().WithRuntimeObjects(objects...).Build()
 			reconciler = NodeHealthCheckReconciler{
@@ -259,6 +276,90 @@ var _ = Describe("Node Health Check CR", func() {
 			})
 		})
 
+		When("a healthy node carries an unhealthy annotation", func() {
+			BeforeEach(func() {
+				setupObjects(0, 3)
+				underTest.Spec.UnhealthyAnnotations = []v1alpha1.UnhealthyAnnotation{
+					{Key: "node-problem/disk-corrupt", Value: "true"},
+				}
+				objects[0].(*v1.Node).SetAnnotations(map[string]string{"node-problem/disk-corrupt": "true"})
+			})
+
+			It("creates a remediation CR based on the annotation alone", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				cr := newRemediationCR(objects[0].(*v1.Node).GetName())
+				err := reconciler.Client.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.GetName()}, &cr)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("doesn't remediate the nodes without the annotation", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				cr := newRemediationCR(objects[1].(*v1.Node).GetName())
+				err := reconciler.Client.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.GetName()}, &cr)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("dry run is enabled", func() {
+			BeforeEach(func() {
+				setupObjects(1, 2)
+				underTest.Spec.DryRun = true
+			})
+
+			It("doesn't create a remediation CR", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				cr := newRemediationCR("unhealthy-node-1")
+				err := reconciler.Client.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.GetName()}, &cr)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("surfaces the would-be remediation as a synthetic status entry", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				Expect(getNHCError).NotTo(HaveOccurred())
+				Expect(underTest.Status.UnhealthyNodes).To(HaveLen(1))
+				Expect(underTest.Status.UnhealthyNodes[0].Name).To(Equal("unhealthy-node-1"))
+				Expect(underTest.Status.UnhealthyNodes[0].Remediations).To(HaveLen(1))
+				remediation := underTest.Status.UnhealthyNodes[0].Remediations[0]
+				Expect(remediation.Kind).To(Equal("InfrastructureRemediation"))
+				Expect(remediation.Name).To(Equal("unhealthy-node-1"))
+				Expect(remediation.DryRun).To(BeTrue())
+			})
+
+			It("reports the DryRun phase", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				Expect(getNHCError).NotTo(HaveOccurred())
+				Expect(underTest.Status.Phase).To(Equal(v1alpha1.PhaseDryRun))
+			})
+		})
+
+		When("the selector matches only control-plane nodes and all are unhealthy", func() {
+			BeforeEach(func() {
+				setupObjects(2, 0)
+				for _, o := range objects {
+					if node, ok := o.(*v1.Node); ok {
+						node.Labels = map[string]string{utils.ControlPlaneRoleLabel: ""}
+					}
+				}
+			})
+
+			It("surfaces the quorum deadlock with an actionable condition", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				Expect(getNHCError).NotTo(HaveOccurred())
+
+				condition := meta.FindStatusCondition(underTest.Status.Conditions, v1alpha1.ConditionTypeQuorumBlocked)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+				Expect(condition.Reason).To(Equal(v1alpha1.ConditionReasonAllControlPlaneUnhealthy))
+				Expect(condition.Message).To(ContainSubstring("allowRemediationWhenAllUnhealthy"))
+
+				// no remediation CR was created
+				cr := newRemediationCR("unhealthy-node-1")
+				err := reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(),
+					Name: cr.GetName()}, &cr)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
 		When("few nodes become healthy", func() {
 			BeforeEach(func() {
 				setupObjects(1, 2)
@@ -332,6 +433,362 @@ var _ = Describe("Node Health Check CR", func() {
 			})
 		})
 
+		When("the operator restarts while a canary remediation is in flight", func() {
+			BeforeEach(func() {
+				setupObjects(2, 5)
+				underTest.Spec.CanaryRemediation = true
+				// the canary CR created before the restart; the fresh reconciler
+				// has no in-memory record of it
+				remediationCR := newRemediationCR("unhealthy-node-1")
+				objects = append(objects, remediationCR.DeepCopyObject())
+			})
+
+			It("rederives the canary and keeps the other unhealthy node deferred", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+
+				cr := newRemediationCR("unhealthy-node-2")
+				err := reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(),
+					Name: cr.GetName()}, &cr)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("the remediation template was changed mid-flight", func() {
+			var oldTemplateRef v1.ObjectReference
+
+			BeforeEach(func() {
+				setupObjects(1, 2)
+				// the template the in-flight CRs were created from, before the spec was changed
+				oldTemplateRef = v1.ObjectReference{
+					Kind:       "OldInfrastructureRemediationTemplate",
+					APIVersion: "medik8s.io/v1alpha1",
+					Namespace:  "default",
+					Name:       "old-template",
+				}
+				underTest.Status.TemplatesInUse = []v1.ObjectReference{oldTemplateRef}
+
+				oldCR := unstructured.Unstructured{}
+				oldCR.SetGroupVersionKind(schema.GroupVersionKind{Group: "medik8s.io", Version: "v1alpha1", Kind: "OldInfrastructureRemediation"})
+				oldCR.SetName("healthy-node-2")
+				oldCR.SetNamespace("default")
+				objects = append(objects, oldCR.DeepCopyObject())
+			})
+
+			It("deletes the old kind remediation CR when the node is healthy again", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				oldCR := unstructured.Unstructured{}
+				oldCR.SetGroupVersionKind(schema.GroupVersionKind{Group: "medik8s.io", Version: "v1alpha1", Kind: "OldInfrastructureRemediation"})
+				err := reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "healthy-node-2"}, &oldCR)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("a node heals right at the escalation boundary", func() {
+			BeforeEach(func() {
+				setupObjects(0, 2)
+				underTest.Spec.EscalatingRemediations = []v1alpha1.EscalatingRemediation{
+					{
+						RemediationTemplate: *underTest.Spec.RemediationTemplate,
+						Order:               1,
+						Timeout:             metav1.Duration{Duration: time.Minute},
+					},
+					{
+						RemediationTemplate: v1.ObjectReference{
+							Kind:       "AggressiveRemediationTemplate",
+							APIVersion: "medik8s.io/v1alpha1",
+							Namespace:  "default",
+							Name:       "aggressive",
+						},
+						Order:   2,
+						Timeout: metav1.Duration{Duration: time.Minute},
+					},
+				}
+			})
+
+			It("cancels the remaining steps instead of creating the next CR", func() {
+				// a first step remediation which timed out while the node was unhealthy
+				timedOutCR := newRemediationCR("healthy-node-1")
+				timedOutCR.SetCreationTimestamp(metav1.Time{Time: time.Now().Add(-2 * time.Minute)})
+				Expect(reconciler.Create(context.Background(), &timedOutCR)).To(Succeed())
+
+				// remediate with a stale unhealthy snapshot of the meanwhile healthy node
+				staleNode := newNode("healthy-node-1", v1.NodeReady, v1.ConditionFalse, time.Minute*10).(*v1.Node)
+				requeue, failed, err := reconciler.remediateEscalating(context.Background(), staleNode, underTest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failed).To(BeFalse())
+				Expect(requeue).To(BeNil())
+
+				nextCR := unstructured.Unstructured{}
+				nextCR.SetGroupVersionKind(schema.GroupVersionKind{Group: "medik8s.io", Version: "v1alpha1", Kind: "AggressiveRemediation"})
+				err = reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "healthy-node-1"}, &nextCR)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+
+				// the timed out CR was cleaned up as well
+				err = reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: timedOutCR.GetNamespace(), Name: timedOutCR.GetName()}, &timedOutCR)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("remediation escalates through multiple steps", func() {
+			BeforeEach(func() {
+				setupObjects(1, 2)
+				underTest.Spec.EscalatingRemediations = []v1alpha1.EscalatingRemediation{
+					{
+						RemediationTemplate: *underTest.Spec.RemediationTemplate,
+						Order:               1,
+						Timeout:             metav1.Duration{Duration: 5 * time.Minute},
+					},
+					{
+						RemediationTemplate: v1.ObjectReference{
+							Kind:       "AggressiveRemediationTemplate",
+							APIVersion: "medik8s.io/v1alpha1",
+							Namespace:  "default",
+							Name:       "aggressive",
+						},
+						Order:   2,
+						Timeout: metav1.Duration{Duration: 5 * time.Minute},
+					},
+				}
+			})
+
+			It("escalates deterministically as the fake clock advances", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+
+				// the first escalation step started during the initial reconcile
+				firstCR := newRemediationCR("unhealthy-node-1")
+				Expect(reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: firstCR.GetNamespace(),
+					Name: firstCR.GetName()}, &firstCR)).To(Succeed())
+
+				fakeClock := injectFakeClock(&reconciler, time.Now())
+				node := &v1.Node{}
+				Expect(reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "unhealthy-node-1"}, node)).To(Succeed())
+
+				// before the timeout the first step stays in flight
+				requeue, failed, err := reconciler.remediateEscalating(context.Background(), node, underTest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failed).To(BeFalse())
+				Expect(requeue).NotTo(BeNil())
+				nextCR := unstructured.Unstructured{}
+				nextCR.SetGroupVersionKind(schema.GroupVersionKind{Group: "medik8s.io", Version: "v1alpha1", Kind: "AggressiveRemediation"})
+				err = reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "unhealthy-node-1"}, &nextCR)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+
+				// past the timeout the remediation escalates to the next step
+				fakeClock.Step(6 * time.Minute)
+				_, failed, err = reconciler.remediateEscalating(context.Background(), node, underTest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failed).To(BeFalse())
+				Expect(reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "unhealthy-node-1"}, &nextCR)).To(Succeed())
+
+				// once the last step timed out there is nothing left to escalate to
+				fakeClock.Step(6 * time.Minute)
+				requeue, failed, err = reconciler.remediateEscalating(context.Background(), node, underTest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(failed).To(BeFalse())
+				Expect(requeue).To(BeNil())
+			})
+		})
+
+		When("a conflicting MHC disables the NHC", func() {
+			BeforeEach(func() {
+				setupObjects(0, 2)
+			})
+
+			It("requeues while disabled and re-enables once the MHC is gone", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				checker := &fakeMHCChecker{needDisable: true}
+				reconciler.MHCChecker = checker
+				reconciler.MHCDisabledRequeueInterval = 2 * time.Minute
+
+				// reset the debounce so the repeated reconciles below aren't deferred
+				reconciler.lastReconciles = nil
+				result, err := reconciler.Reconcile(context.Background(),
+					controllerruntime.Request{NamespacedName: types.NamespacedName{Name: underTest.Name}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(2 * time.Minute))
+				Expect(reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: underTest.Name}, underTest)).To(Succeed())
+				Expect(meta.IsStatusConditionTrue(underTest.Status.Conditions, v1alpha1.ConditionTypeDisabled)).To(BeTrue())
+
+				checker.needDisable = false
+				reconciler.lastReconciles = nil
+				_, err = reconciler.Reconcile(context.Background(),
+					controllerruntime.Request{NamespacedName: types.NamespacedName{Name: underTest.Name}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: underTest.Name}, underTest)).To(Succeed())
+				Expect(meta.IsStatusConditionTrue(underTest.Status.Conditions, v1alpha1.ConditionTypeDisabled)).To(BeFalse())
+			})
+		})
+
+		When("an orphaned remediation lease exists", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("DEPLOYMENT_NAMESPACE", "default")).To(Succeed())
+				setupObjects(0, 2)
+				// a lease left behind by a crash between lease and CR creation,
+				// with neither an in-flight remediation nor an unhealthy node
+				holder := "NodeHealthCheck/test"
+				duration := int32(600)
+				lease := &coordv1.Lease{
+					ObjectMeta: metav1.ObjectMeta{Name: "nhc-gone-node", Namespace: "default"},
+					Spec: coordv1.LeaseSpec{
+						HolderIdentity:       &holder,
+						LeaseDurationSeconds: &duration,
+					},
+				}
+				objects = append(objects, lease)
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("DEPLOYMENT_NAMESPACE")).To(Succeed())
+			})
+
+			It("releases the orphaned lease", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				lease := &coordv1.Lease{}
+				err := reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "nhc-gone-node"}, lease)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("the remediation lease cannot be created", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("DEPLOYMENT_NAMESPACE", "default")).To(Succeed())
+				setupObjects(1, 2)
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("DEPLOYMENT_NAMESPACE")).To(Succeed())
+			})
+
+			It("defers remediation instead of creating an unleased CR", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+
+				// drop the remediation and the lease the initial reconcile created,
+				// then break lease creation for the next attempt
+				cr := newRemediationCR("unhealthy-node-1")
+				Expect(reconciler.Delete(context.Background(), &cr)).To(Succeed())
+				lease := &coordv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: "nhc-unhealthy-node-1", Namespace: "default"}}
+				Expect(reconciler.Delete(context.Background(), lease)).To(Succeed())
+				reconciler.Client = leaseCreateFailingClient{reconciler.Client}
+
+				// reset the debounce so the repeated reconcile isn't deferred
+				reconciler.lastReconciles = nil
+				result, err := reconciler.Reconcile(context.Background(),
+					controllerruntime.Request{NamespacedName: types.NamespacedName{Name: underTest.Name}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(1 * time.Minute))
+
+				err = reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(),
+					Name: cr.GetName()}, &cr)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("the node's remediation was exhausted before an operator restart", func() {
+			BeforeEach(func() {
+				setupObjects(1, 2)
+				// the exhaustion annotation persisted by the Delete timeout
+				// policy, while the fresh reconciler's in-memory state is empty
+				underTest.SetUID("nhc-uid")
+				node := objects[0].(*v1.Node)
+				node.SetUID("unhealthy-node-1-uid")
+				node.SetAnnotations(map[string]string{
+					"remediation.medik8s.io/remediation-exhausted": (&NodeHealthCheckReconciler{}).dedupKey(underTest, node),
+				})
+			})
+
+			It("doesn't retry the destructive remediation", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				cr := newRemediationCR("unhealthy-node-1")
+				err := reconciler.Client.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.GetName()}, &cr)
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		When("another NHC holds the node's remediation lease", func() {
+			newLease := func(renewedAt time.Time) *coordv1.Lease {
+				holder := "NodeHealthCheck/other"
+				duration := int32(600)
+				renewTime := metav1.NewMicroTime(renewedAt)
+				return &coordv1.Lease{
+					ObjectMeta: metav1.ObjectMeta{Name: "nhc-unhealthy-node-1", Namespace: "default"},
+					Spec: coordv1.LeaseSpec{
+						HolderIdentity:       &holder,
+						LeaseDurationSeconds: &duration,
+						RenewTime:            &renewTime,
+					},
+				}
+			}
+
+			Context("and the lease is still valid", func() {
+				BeforeEach(func() {
+					setupObjects(1, 2)
+					objects = append(objects, newLease(time.Now()))
+				})
+
+				It("defers remediation instead of remediating a node another NHC works on", func() {
+					Expect(reconcileError).NotTo(HaveOccurred())
+					cr := newRemediationCR("unhealthy-node-1")
+					err := reconciler.Client.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.GetName()}, &cr)
+					Expect(errors.IsNotFound(err)).To(BeTrue())
+				})
+			})
+
+			Context("and the lease is expired", func() {
+				BeforeEach(func() {
+					setupObjects(1, 2)
+					objects = append(objects, newLease(time.Now().Add(-11*time.Minute)))
+				})
+
+				It("takes over the lease and remediates", func() {
+					Expect(reconcileError).NotTo(HaveOccurred())
+					cr := newRemediationCR("unhealthy-node-1")
+					err := reconciler.Client.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: cr.GetNamespace(), Name: cr.GetName()}, &cr)
+					Expect(err).NotTo(HaveOccurred())
+
+					lease := &coordv1.Lease{}
+					Expect(reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "nhc-unhealthy-node-1"}, lease)).To(Succeed())
+					Expect(lease.Spec.HolderIdentity).NotTo(BeNil())
+					Expect(*lease.Spec.HolderIdentity).To(Equal("NodeHealthCheck/test"))
+				})
+			})
+		})
+
+		When("a concurrent writer conflicts with a status update", func() {
+			BeforeEach(func() {
+				setupObjects(0, 2)
+			})
+
+			It("retries the update instead of failing the reconcile", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				reconciler.MHCChecker = &fakeMHCChecker{needDisable: true}
+				reconciler.Client = &conflictOnceStatusClient{Client: reconciler.Client}
+
+				// reset the debounce so the repeated reconcile isn't deferred
+				reconciler.lastReconciles = nil
+				_, err := reconciler.Reconcile(context.Background(),
+					controllerruntime.Request{NamespacedName: types.NamespacedName{Name: underTest.Name}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(reconciler.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: underTest.Name}, underTest)).To(Succeed())
+				Expect(meta.IsStatusConditionTrue(underTest.Status.Conditions, v1alpha1.ConditionTypeDisabled)).To(BeTrue())
+			})
+		})
+
+		When("only a status condition changes but the node counts don't", func() {
+			BeforeEach(func() {
+				setupObjects(0, 2)
+				// make the counts match the reconcile outcome, so only the condition changes
+				underTest.Status.ObservedNodes = 2
+				underTest.Status.HealthyNodes = 2
+			})
+
+			It("still persists the condition", func() {
+				Expect(reconcileError).NotTo(HaveOccurred())
+				Expect(getNHCError).NotTo(HaveOccurred())
+				Expect(meta.IsStatusConditionTrue(underTest.Status.Conditions, v1alpha1.ConditionTypeAllNodesHealthy)).To(BeTrue())
+			})
+		})
+
 		When("Nodes are candidates for remediation and cluster is upgrading", func() {
 			BeforeEach(func() {
 				objects = newNodes(1, 2)
@@ -443,6 +900,501 @@ func newRemediationCR(nodeName string) unstructured.Unstructured {
 	return cr
 }
 
+var _ = Describe("Condition profile resolution", func() {
+
+	var (
+		reconciler *NodeHealthCheckReconciler
+		nhc        *v1alpha1.NodeHealthCheck
+		node       *v1.Node
+	)
+
+	newProfile := func(matchLabels map[string]string, conditionType v1.NodeConditionType) v1alpha1.ConditionProfile {
+		return v1alpha1.ConditionProfile{
+			Selector: metav1.LabelSelector{MatchLabels: matchLabels},
+			UnhealthyConditions: []v1alpha1.UnhealthyCondition{
+				{Type: conditionType, Status: v1.ConditionFalse, Duration: metav1.Duration{Duration: time.Minute}},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		reconciler = &NodeHealthCheckReconciler{Log: controllerruntime.Log.WithName("NHC Test Reconciler")}
+		nhc = newNodeHealthCheck()
+		node = newNode("profiled-node", v1.NodeReady, v1.ConditionFalse, time.Minute).(*v1.Node)
+		node.Labels = map[string]string{"role": "edge", "zone": "a"}
+		nhc.Spec.ConditionProfiles = []v1alpha1.ConditionProfile{
+			newProfile(map[string]string{"role": "edge"}, v1.NodeReady),
+			newProfile(map[string]string{"role": "edge", "zone": "a"}, v1.NodeDiskPressure),
+		}
+	})
+
+	It("should use the first matching profile by default", func() {
+		conditions := reconciler.conditionsForNode(nhc, node)
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Type).To(Equal(v1.NodeReady))
+	})
+
+	It("should use the most specific matching profile when configured", func() {
+		nhc.Spec.ConditionProfileResolution = v1alpha1.ConditionProfileResolutionMostSpecific
+		conditions := reconciler.conditionsForNode(nhc, node)
+		Expect(conditions).To(HaveLen(1))
+		Expect(conditions[0].Type).To(Equal(v1.NodeDiskPressure))
+	})
+
+	It("should break specificity ties by list order", func() {
+		nhc.Spec.ConditionProfileResolution = v1alpha1.ConditionProfileResolutionMostSpecific
+		nhc.Spec.ConditionProfiles = []v1alpha1.ConditionProfile{
+			newProfile(map[string]string{"role": "edge"}, v1.NodeReady),
+			newProfile(map[string]string{"zone": "a"}, v1.NodeDiskPressure),
+		}
+		conditions := reconciler.conditionsForNode(nhc, node)
+		Expect(conditions[0].Type).To(Equal(v1.NodeReady))
+	})
+
+	It("should fall back to the base conditions when no profile matches", func() {
+		node.Labels = map[string]string{"role": "datacenter"}
+		conditions := reconciler.conditionsForNode(nhc, node)
+		Expect(conditions).To(Equal(nhc.Spec.UnhealthyConditions))
+	})
+
+	It("should derive the unhealthy transition from the matching profile's conditions", func() {
+		diskPressureSince := metav1.Time{Time: time.Now().Add(-10 * time.Minute)}
+		node.Status.Conditions = append(node.Status.Conditions, v1.NodeCondition{
+			Type:               v1.NodeDiskPressure,
+			Status:             v1.ConditionFalse,
+			LastTransitionTime: diskPressureSince,
+		})
+		nhc.Spec.ConditionProfileResolution = v1alpha1.ConditionProfileResolutionMostSpecific
+		transition := reconciler.unhealthyTransition(nhc, node)
+		Expect(transition).NotTo(BeNil())
+		Expect(transition.Time).To(BeTemporally("==", diskPressureSince.Time))
+	})
+})
+
+var _ = Describe("Annotation write time from managed fields", func() {
+
+	const annotationKey = "node-problem/disk-corrupt"
+
+	newNodeWithManagedFields := func(raw string, at time.Time) *v1.Node {
+		node := &v1.Node{}
+		entryTime := metav1.NewTime(at)
+		node.SetManagedFields([]metav1.ManagedFieldsEntry{{
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(raw)},
+			Time:     &entryTime,
+		}})
+		return node
+	}
+
+	It("returns the writing manager's update time for the annotation", func() {
+		writtenAt := time.Now().Add(-10 * time.Minute).Truncate(time.Second)
+		node := newNodeWithManagedFields(`{"f:metadata":{"f:annotations":{"f:`+annotationKey+`":{}}}}`, writtenAt)
+		managed := annotationManagedTime(node, annotationKey)
+		Expect(managed).NotTo(BeNil())
+		Expect(*managed).To(BeTemporally("==", writtenAt))
+	})
+
+	It("ignores a label with the same key", func() {
+		node := newNodeWithManagedFields(`{"f:metadata":{"f:labels":{"f:`+annotationKey+`":{}}}}`, time.Now())
+		Expect(annotationManagedTime(node, annotationKey)).To(BeNil())
+	})
+
+	It("ignores malformed fieldsV1 entries", func() {
+		node := newNodeWithManagedFields(`not json`, time.Now())
+		Expect(annotationManagedTime(node, annotationKey)).To(BeNil())
+	})
+})
+
+var _ = Describe("Old remediation CR alerting", func() {
+
+	var (
+		reconciler *NodeHealthCheckReconciler
+		fakeClock  *testclock.FakeClock
+		nhc        *v1alpha1.NodeHealthCheck
+		cr         *unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		start := time.Now()
+		crObject := newRemediationCR("unhealthy-node-1")
+		cr = &crObject
+		cr.SetCreationTimestamp(metav1.Time{Time: start})
+		nhc = newNodeHealthCheck()
+		nhc.Spec.RemediationCRAlertTimeout = &metav1.Duration{Duration: 2 * time.Hour}
+		reconciler = &NodeHealthCheckReconciler{
+			Client: fake.NewClientBuilder().WithRuntimeObjects(cr).Build(),
+			Log:    controllerruntime.Log.WithName("NHC Test Reconciler"),
+		}
+		fakeClock = injectFakeClock(reconciler, start)
+	})
+
+	It("does not flag the CR before the configured timeout", func() {
+		fakeClock.Step(time.Hour)
+		isAlert, nextReconcile := reconciler.alertOldRemediationCR(nhc, cr)
+		Expect(isAlert).To(BeFalse())
+		Expect(nextReconcile).ToNot(BeNil())
+		Expect(*nextReconcile).To(BeNumerically("~", time.Hour+time.Minute, time.Second))
+	})
+
+	It("flags the CR at the configured boundary instead of the 48h default", func() {
+		fakeClock.Step(2*time.Hour + time.Minute)
+		isAlert, _ := reconciler.alertOldRemediationCR(nhc, cr)
+		Expect(isAlert).To(BeTrue())
+		Expect(cr.GetAnnotations()).To(HaveKey("nodehealthcheck.medik8s.io/old-remediation-cr-flag"))
+	})
+
+	It("flags the CR only once", func() {
+		fakeClock.Step(2*time.Hour + time.Minute)
+		isAlert, _ := reconciler.alertOldRemediationCR(nhc, cr)
+		Expect(isAlert).To(BeTrue())
+		isAlert, _ = reconciler.alertOldRemediationCR(nhc, cr)
+		Expect(isAlert).To(BeFalse())
+	})
+})
+
+var _ = Describe("Remediation success rate", func() {
+
+	var (
+		reconciler *NodeHealthCheckReconciler
+		fakeClock  *testclock.FakeClock
+		nhc        *v1alpha1.NodeHealthCheck
+	)
+
+	BeforeEach(func() {
+		nhc = newNodeHealthCheck()
+		reconciler = &NodeHealthCheckReconciler{
+			Client: fake.NewClientBuilder().WithRuntimeObjects(nhc).Build(),
+			Log:    controllerruntime.Log.WithName("NHC Test Reconciler"),
+		}
+		fakeClock = injectFakeClock(reconciler, time.Now())
+	})
+
+	patchStatus := func() {
+		ExpectWithOffset(1, reconciler.patchStatus(nhc, 3, nil, nil, nil, nil, nil, nil, nil)).To(Succeed())
+	}
+
+	It("reports unknown while no remediation completed", func() {
+		patchStatus()
+		Expect(nhc.Status.RemediationSuccessRate).To(Equal("unknown"))
+	})
+
+	It("reports the share of recovered nodes over the completed remediations", func() {
+		reconciler.recordRemediationCompleted(nhc, "unhealthy-node-1", "InfrastructureRemediation", remediationOutcomeRecovered)
+		reconciler.recordRemediationCompleted(nhc, "unhealthy-node-2", "InfrastructureRemediation", "failed")
+		patchStatus()
+		Expect(nhc.Status.RemediationSuccessRate).To(Equal("50% (1/2)"))
+	})
+
+	It("drops completions which fell out of the rolling window", func() {
+		reconciler.recordRemediationCompleted(nhc, "unhealthy-node-1", "InfrastructureRemediation", "failed")
+		fakeClock.Step(RemediationSuccessRateWindow + time.Minute)
+		reconciler.recordRemediationCompleted(nhc, "unhealthy-node-2", "InfrastructureRemediation", remediationOutcomeRecovered)
+		patchStatus()
+		Expect(nhc.Status.RemediationSuccessRate).To(Equal("100% (1/1)"))
+	})
+
+	It("reports unknown again once the whole window is empty", func() {
+		reconciler.recordRemediationCompleted(nhc, "unhealthy-node-1", "InfrastructureRemediation", remediationOutcomeRecovered)
+		fakeClock.Step(RemediationSuccessRateWindow + time.Minute)
+		patchStatus()
+		Expect(nhc.Status.RemediationSuccessRate).To(Equal("unknown"))
+	})
+})
+
+var _ = Describe("NodeUnhealthy transition events", func() {
+
+	var (
+		reconciler *NodeHealthCheckReconciler
+		recorder   *record.FakeRecorder
+		nhc        *v1alpha1.NodeHealthCheck
+		node       *v1.Node
+	)
+
+	BeforeEach(func() {
+		nhc = newNodeHealthCheck()
+		node = newNode("unhealthy-node-1", v1.NodeReady, v1.ConditionFalse, 10*time.Minute).(*v1.Node)
+		recorder = record.NewFakeRecorder(10)
+		reconciler = &NodeHealthCheckReconciler{
+			Client:     fake.NewClientBuilder().Build(),
+			Log:        controllerruntime.Log.WithName("NHC Test Reconciler"),
+			Recorder:   recorder,
+			MHCChecker: mhc.DummyChecker{},
+		}
+	})
+
+	checkHealth := func() {
+		_, err := reconciler.checkNodesHealth([]v1.Node{*node}, nhc)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	nodeUnhealthyEvents := func() []string {
+		var events []string
+		for {
+			select {
+			case event := <-recorder.Events:
+				if strings.Contains(event, eventReasonNodeUnhealthy) {
+					events = append(events, event)
+				}
+			default:
+				return events
+			}
+		}
+	}
+
+	It("emits the event exactly once across repeated reconciles", func() {
+		checkHealth()
+		checkHealth()
+		checkHealth()
+		events := nodeUnhealthyEvents()
+		Expect(events).To(HaveLen(1))
+		Expect(events[0]).To(ContainSubstring("unhealthy-node-1"))
+		Expect(events[0]).To(ContainSubstring("Ready=False"))
+	})
+
+	It("emits the event again for the next unhealthy transition", func() {
+		checkHealth()
+		node.Status.Conditions[0].Status = v1.ConditionTrue
+		checkHealth()
+		node.Status.Conditions[0].Status = v1.ConditionFalse
+		checkHealth()
+		Expect(nodeUnhealthyEvents()).To(HaveLen(2))
+	})
+
+	It("treats a different tripped condition as a new transition", func() {
+		nhc.Spec.UnhealthyConditions = append(nhc.Spec.UnhealthyConditions, v1alpha1.UnhealthyCondition{
+			Type:     v1.NodeReady,
+			Status:   v1.ConditionUnknown,
+			Duration: metav1.Duration{Duration: time.Second * 300},
+		})
+		checkHealth()
+		node.Status.Conditions[0].Status = v1.ConditionUnknown
+		checkHealth()
+		checkHealth()
+		events := nodeUnhealthyEvents()
+		Expect(events).To(HaveLen(2))
+		Expect(events[1]).To(ContainSubstring("Ready=Unknown"))
+	})
+})
+
+var _ = Describe("Unhealthy taints", func() {
+
+	const taintKey = "node.medik8s.io/failed"
+
+	var (
+		reconciler *NodeHealthCheckReconciler
+		nhc        *v1alpha1.NodeHealthCheck
+		node       *v1.Node
+	)
+
+	BeforeEach(func() {
+		reconciler = &NodeHealthCheckReconciler{Log: controllerruntime.Log.WithName("NHC Test Reconciler")}
+		nhc = newNodeHealthCheck()
+		nhc.Spec.UnhealthyTaints = []v1alpha1.UnhealthyTaint{
+			{Key: taintKey, Effect: v1.TaintEffectNoSchedule, Duration: &metav1.Duration{Duration: 5 * time.Minute}},
+		}
+		node = newNode("tainted-node", v1.NodeReady, v1.ConditionTrue, time.Minute).(*v1.Node)
+	})
+
+	taintNode := func(effect v1.TaintEffect, addedAgo time.Duration) {
+		timeAdded := metav1.NewTime(time.Now().Add(-addedAgo))
+		node.Spec.Taints = []v1.Taint{{Key: taintKey, Effect: effect, TimeAdded: &timeAdded}}
+	}
+
+	It("keeps an untainted node healthy", func() {
+		Expect(reconciler.taintsHealthy(nhc, node)).To(BeTrue())
+	})
+
+	It("keeps the node healthy while the taint is within its duration", func() {
+		taintNode(v1.TaintEffectNoSchedule, time.Minute)
+		Expect(reconciler.taintsHealthy(nhc, node)).To(BeTrue())
+	})
+
+	It("marks the node unhealthy once the taint outlasted its duration", func() {
+		taintNode(v1.TaintEffectNoSchedule, 10*time.Minute)
+		Expect(reconciler.taintsHealthy(nhc, node)).To(BeFalse())
+	})
+
+	It("ignores a taint with a different effect", func() {
+		taintNode(v1.TaintEffectNoExecute, 10*time.Minute)
+		Expect(reconciler.taintsHealthy(nhc, node)).To(BeTrue())
+	})
+
+	It("matches any effect when none is configured", func() {
+		nhc.Spec.UnhealthyTaints[0].Effect = ""
+		taintNode(v1.TaintEffectNoExecute, 10*time.Minute)
+		Expect(reconciler.taintsHealthy(nhc, node)).To(BeFalse())
+	})
+
+	It("recovers once the taint is removed", func() {
+		taintNode(v1.TaintEffectNoSchedule, 10*time.Minute)
+		Expect(reconciler.taintsHealthy(nhc, node)).To(BeFalse())
+		node.Spec.Taints = nil
+		Expect(reconciler.taintsHealthy(nhc, node)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Condition flap thresholds", func() {
+
+	var (
+		reconciler *NodeHealthCheckReconciler
+		nhc        *v1alpha1.NodeHealthCheck
+		node       *v1.Node
+	)
+
+	BeforeEach(func() {
+		reconciler = &NodeHealthCheckReconciler{Log: controllerruntime.Log.WithName("NHC Test Reconciler")}
+		nhc = newNodeHealthCheck()
+		nhc.Spec.ConditionFlapThresholds = []v1alpha1.ConditionFlapThreshold{
+			{Type: v1.NodeReady, MaxTransitions: 2, Window: metav1.Duration{Duration: time.Hour}},
+		}
+		node = newNode("flapping-node", v1.NodeReady, v1.ConditionTrue, time.Minute).(*v1.Node)
+	})
+
+	observeTransition := func(ago time.Duration) bool {
+		node.Status.Conditions[0].LastTransitionTime = metav1.NewTime(time.Now().Add(-ago))
+		return reconciler.conditionsNotFlapping(nhc, node)
+	}
+
+	It("stays healthy within the allowed transitions", func() {
+		Expect(observeTransition(10 * time.Minute)).To(BeTrue())
+		Expect(observeTransition(5 * time.Minute)).To(BeTrue())
+	})
+
+	It("marks the node unhealthy once it transitioned more often than allowed", func() {
+		observeTransition(10 * time.Minute)
+		observeTransition(5 * time.Minute)
+		Expect(observeTransition(time.Minute)).To(BeFalse())
+	})
+
+	It("forgets transitions which left the window", func() {
+		observeTransition(2 * time.Hour)
+		observeTransition(10 * time.Minute)
+		observeTransition(5 * time.Minute)
+		Expect(observeTransition(time.Minute)).To(BeFalse())
+		// let the oldest transitions age out of the window
+		nhc.Spec.ConditionFlapThresholds[0].Window = metav1.Duration{Duration: 3 * time.Minute}
+		Expect(reconciler.conditionsNotFlapping(nhc, node)).To(BeTrue())
+	})
+})
+
+var _ = Describe("Minimum kubelet version", func() {
+
+	var (
+		reconciler *NodeHealthCheckReconciler
+		nhc        *v1alpha1.NodeHealthCheck
+		node       *v1.Node
+	)
+
+	BeforeEach(func() {
+		reconciler = &NodeHealthCheckReconciler{Log: controllerruntime.Log.WithName("NHC Test Reconciler")}
+		nhc = newNodeHealthCheck()
+		nhc.Spec.MinKubeletVersion = "v1.25.0"
+		node = newNode("outdated-node", v1.NodeReady, v1.ConditionTrue, time.Minute).(*v1.Node)
+	})
+
+	withKubeletVersion := func(version string) {
+		node.Status.NodeInfo.KubeletVersion = version
+	}
+
+	It("keeps a node on the minimum version healthy", func() {
+		withKubeletVersion("v1.25.0")
+		Expect(reconciler.kubeletVersionCurrent(nhc, node)).To(BeTrue())
+	})
+
+	It("marks a node below the minimum unhealthy", func() {
+		withKubeletVersion("v1.24.7")
+		Expect(reconciler.kubeletVersionCurrent(nhc, node)).To(BeFalse())
+	})
+
+	It("grants the configured grace period before remediating", func() {
+		nhc.Spec.MinKubeletVersionDuration = &metav1.Duration{Duration: time.Hour}
+		withKubeletVersion("v1.24.7")
+		Expect(reconciler.kubeletVersionCurrent(nhc, node)).To(BeTrue())
+	})
+
+	It("keeps a node with an unparsable version healthy", func() {
+		withKubeletVersion("not-a-version")
+		Expect(reconciler.kubeletVersionCurrent(nhc, node)).To(BeTrue())
+	})
+})
+
+var _ = Describe("NHC inheritance", func() {
+
+	var (
+		reconciler *NodeHealthCheckReconciler
+		parent     *v1alpha1.NodeHealthCheck
+		child      *v1alpha1.NodeHealthCheck
+	)
+
+	BeforeEach(func() {
+		parent = newNodeHealthCheck()
+		parent.Name = "parent"
+		parent.Spec.Selector = metav1.LabelSelector{MatchLabels: map[string]string{"role": "worker"}}
+		child = &v1alpha1.NodeHealthCheck{
+			ObjectMeta: metav1.ObjectMeta{Name: "child"},
+			Spec: v1alpha1.NodeHealthCheckSpec{
+				InheritFrom: &v1.LocalObjectReference{Name: "parent"},
+			},
+		}
+		reconciler = &NodeHealthCheckReconciler{
+			Client: fake.NewClientBuilder().WithRuntimeObjects(parent, child).Build(),
+			Log:    controllerruntime.Log.WithName("NHC Test Reconciler"),
+		}
+	})
+
+	It("fills the child's unset fields from the parent", func() {
+		Expect(reconciler.resolveInheritance(context.Background(), child)).To(Succeed())
+		Expect(child.Spec.Selector).To(Equal(parent.Spec.Selector))
+		Expect(child.Spec.UnhealthyConditions).To(Equal(parent.Spec.UnhealthyConditions))
+		Expect(child.Spec.MinHealthy).To(Equal(parent.Spec.MinHealthy))
+		Expect(child.Spec.RemediationTemplate).To(Equal(parent.Spec.RemediationTemplate))
+	})
+
+	It("keeps the child's own settings", func() {
+		minHealthy := intstr.FromString("80%")
+		child.Spec.MinHealthy = &minHealthy
+		Expect(reconciler.resolveInheritance(context.Background(), child)).To(Succeed())
+		Expect(child.Spec.MinHealthy).To(Equal(&minHealthy))
+	})
+
+	It("detects inheritance cycles", func() {
+		parent.Spec.InheritFrom = &v1.LocalObjectReference{Name: "child"}
+		Expect(reconciler.Update(context.Background(), parent)).To(Succeed())
+		err := reconciler.resolveInheritance(context.Background(), child)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("inheritance cycle"))
+	})
+})
+
+var _ = Describe("Remediation result notifier", func() {
+
+	It("delivers the result as JSON to the configured URL", func() {
+		var payloads []remediationResult
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var result remediationResult
+			Expect(json.NewDecoder(r.Body).Decode(&result)).To(Succeed())
+			payloads = append(payloads, result)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		n := &notifier{
+			queue:  make(chan notification, 1),
+			client: server.Client(),
+			log:    controllerruntime.Log.WithName("notifier test"),
+		}
+		n.deliver(notification{url: server.URL, result: remediationResult{
+			NodeHealthCheck: "test",
+			Node:            "unhealthy-node-1",
+			Outcome:         remediationOutcomeRecovered,
+			Remediator:      "InfrastructureRemediation",
+		}})
+
+		Expect(payloads).To(HaveLen(1))
+		Expect(payloads[0].Node).To(Equal("unhealthy-node-1"))
+		Expect(payloads[0].Outcome).To(Equal(remediationOutcomeRecovered))
+	})
+})
+
 func newRemediationTemplate() runtime.Object {
 	r := map[string]interface{}{
 		"kind":       "InfrastructureRemediation",
@@ -573,6 +1525,53 @@ var TestRemediationCRD = &apiextensions.CustomResourceDefinition{
 	},
 }
 
+// conflictOnceStatusClient fails the first status update with a conflict, for
+// testing the conflict-retry path of status writes.
+type conflictOnceStatusClient struct {
+	ctrlruntimeclient.Client
+	conflicted bool
+}
+
+func (c *conflictOnceStatusClient) Status() ctrlruntimeclient.StatusWriter {
+	return &conflictOnceStatusWriter{StatusWriter: c.Client.Status(), client: c}
+}
+
+type conflictOnceStatusWriter struct {
+	ctrlruntimeclient.StatusWriter
+	client *conflictOnceStatusClient
+}
+
+func (w *conflictOnceStatusWriter) Update(ctx context.Context, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.UpdateOption) error {
+	if !w.client.conflicted {
+		w.client.conflicted = true
+		return errors.NewConflict(schema.GroupResource{Group: "remediation.medik8s.io", Resource: "nodehealthchecks"},
+			obj.GetName(), fmt.Errorf("simulated concurrent status write"))
+	}
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+// leaseCreateFailingClient fails every lease creation, for testing that no
+// remediation starts without a confirmed lease.
+type leaseCreateFailingClient struct {
+	ctrlruntimeclient.Client
+}
+
+func (c leaseCreateFailingClient) Create(ctx context.Context, obj ctrlruntimeclient.Object, opts ...ctrlruntimeclient.CreateOption) error {
+	if _, isLease := obj.(*coordv1.Lease); isLease {
+		return fmt.Errorf("lease creation is broken")
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+// injectFakeClock swaps the reconciler's time source for a fake clock starting
+// at the given time, so tests can drive escalation timing with fakeClock.Step
+// instead of sleeping.
+func injectFakeClock(r *NodeHealthCheckReconciler, start time.Time) *testclock.FakeClock {
+	fakeClock := testclock.NewFakeClock(start)
+	r.clock = fakeClock
+	return fakeClock
+}
+
 type fakeClusterUpgradeChecker struct {
 	upgrading bool
 	err       error
@@ -584,3 +1583,26 @@ var _ cluster.UpgradeChecker = fakeClusterUpgradeChecker{}
 func (c fakeClusterUpgradeChecker) Check() (bool, error) {
 	return c.upgrading, c.err
 }
+
+type fakeMHCChecker struct {
+	needDisable bool
+}
+
+// force implementation of interface
+var _ mhc.Checker = &fakeMHCChecker{}
+
+func (c *fakeMHCChecker) Start(_ context.Context) error {
+	return nil
+}
+
+func (c *fakeMHCChecker) UpdateStatus() error {
+	return nil
+}
+
+func (c *fakeMHCChecker) NeedDisableNHC() bool {
+	return c.needDisable
+}
+
+func (c *fakeMHCChecker) NeedIgnoreNode(_ *v1.Node) bool {
+	return false
+}
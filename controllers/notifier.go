@@ -0,0 +1,121 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// remediationOutcomeRecovered is reported when the node turned healthy and
+	// its remediation CRs were removed
+	remediationOutcomeRecovered = "recovered"
+
+	// remediationOutcomeExhausted is reported when a timed out remediation was
+	// deleted without retrying
+	remediationOutcomeExhausted = "exhausted"
+)
+
+// remediationResult is the structured payload POSTed to the configured
+// notification URL when a remediation completes, so external dashboards can
+// track end-to-end remediation outcomes.
+type remediationResult struct {
+	NodeHealthCheck string      `json:"nodeHealthCheck"`
+	Node            string      `json:"node"`
+	Outcome         string      `json:"outcome"`
+	Remediator      string      `json:"remediator"`
+	Duration        string      `json:"duration"`
+	EscalationSteps int         `json:"escalationSteps"`
+	CompletedAt     metav1.Time `json:"completedAt"`
+}
+
+// notification is a queued delivery of one remediation result to one URL.
+type notification struct {
+	url    string
+	result remediationResult
+}
+
+// notifier delivers remediation results to external URLs without blocking the
+// reconcile loop. Deliveries run in a background goroutine and are retried a
+// few times with backoff; after that the result is dropped with a log entry,
+// notifications are best-effort by design.
+type notifier struct {
+	queue  chan notification
+	client *http.Client
+	log    logr.Logger
+}
+
+func newNotifier(log logr.Logger) *notifier {
+	n := &notifier{
+		queue:  make(chan notification, 100),
+		client: &http.Client{Timeout: 5 * time.Second},
+		log:    log,
+	}
+	go n.run()
+	return n
+}
+
+// notify enqueues the result for delivery. It never blocks; when the queue is
+// full the result is dropped with a log entry.
+func (n *notifier) notify(url string, result remediationResult) {
+	select {
+	case n.queue <- notification{url: url, result: result}:
+	default:
+		n.log.Info("dropping remediation result notification, the delivery queue is full",
+			"url", url, "nodeName", result.Node)
+	}
+}
+
+func (n *notifier) run() {
+	for item := range n.queue {
+		n.deliver(item)
+	}
+}
+
+func (n *notifier) deliver(item notification) {
+	payload, err := json.Marshal(item.result)
+	if err != nil {
+		n.log.Error(err, "failed to marshal a remediation result notification", "nodeName", item.result.Node)
+		return
+	}
+	var lastErr error
+	for attempt := 0; attempt < 3; attempt++ {
+		if attempt > 0 {
+			time.Sleep(10 * time.Second)
+		}
+		resp, err := n.client.Post(item.url, "application/json", bytes.NewReader(payload))
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return
+		}
+		lastErr = errors.Errorf("notification endpoint returned status %d", resp.StatusCode)
+	}
+	n.log.Error(lastErr, "giving up on delivering a remediation result notification",
+		"url", item.url, "nodeName", item.result.Node)
+}
@@ -0,0 +1,71 @@
+package peer
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Confirmer asks a peer endpoint whether a node is unhealthy from another
+// vantage point, in order to avoid partition-induced mass remediation in
+// federated setups.
+type Confirmer interface {
+	// Confirm returns false only when the peer explicitly reports the node as
+	// healthy. Unreachable peers and unexpected responses are non-fatal, and
+	// result in confirmation as if no peer was configured.
+	Confirm(nodeName string) bool
+}
+
+type httpConfirmer struct {
+	endpoint string
+	timeout  time.Duration
+	logger   logr.Logger
+}
+
+// force implementation of interface
+var _ Confirmer = httpConfirmer{}
+
+// NewHTTPConfirmer returns a Confirmer which queries the given HTTP endpoint
+// with the node name as the "node" query parameter. A 2xx response confirms
+// the node as unhealthy, a 409 response means the peer considers the node
+// healthy, and everything else is treated as an unreachable peer.
+func NewHTTPConfirmer(endpoint string, timeout time.Duration, logger logr.Logger) Confirmer {
+	return httpConfirmer{
+		endpoint: endpoint,
+		timeout:  timeout,
+		logger:   logger,
+	}
+}
+
+func (h httpConfirmer) Confirm(nodeName string) bool {
+	confirmURL, err := url.Parse(h.endpoint)
+	if err != nil {
+		h.logger.Error(err, "failed to parse the peer confirmation endpoint, proceeding without peer confirmation", "endpoint", h.endpoint)
+		return true
+	}
+	query := confirmURL.Query()
+	query.Set("node", nodeName)
+	confirmURL.RawQuery = query.Encode()
+
+	client := http.Client{Timeout: h.timeout}
+	resp, err := client.Get(confirmURL.String())
+	if err != nil {
+		h.logger.Info("peer confirmation endpoint is unreachable, proceeding without peer confirmation", "endpoint", h.endpoint, "error", err.Error())
+		return true
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusConflict {
+		h.logger.Info("peer reported the node as healthy, holding back remediation", "node", nodeName)
+		return false
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return true
+	}
+	h.logger.Info("unexpected peer confirmation response, proceeding without peer confirmation",
+		"node", nodeName, "status", fmt.Sprintf("%d", resp.StatusCode))
+	return true
+}
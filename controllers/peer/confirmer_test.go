@@ -0,0 +1,70 @@
+package peer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var _ = Describe("HTTP Confirmer", func() {
+
+	var log = ctrl.Log.WithName("peer confirmer test")
+
+	newConfirmer := func(status int, requests *[]*http.Request) (Confirmer, *httptest.Server) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if requests != nil {
+				*requests = append(*requests, r)
+			}
+			w.WriteHeader(status)
+		}))
+		return NewHTTPConfirmer(server.URL, 1*time.Second, log), server
+	}
+
+	It("passes the node name as the node query parameter", func() {
+		var requests []*http.Request
+		confirmer, server := newConfirmer(http.StatusOK, &requests)
+		defer server.Close()
+
+		confirmer.Confirm("unhealthy-node-1")
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].URL.Query().Get("node")).To(Equal("unhealthy-node-1"))
+	})
+
+	It("confirms the node on a 2xx response", func() {
+		confirmer, server := newConfirmer(http.StatusNoContent, nil)
+		defer server.Close()
+
+		Expect(confirmer.Confirm("unhealthy-node-1")).To(BeTrue())
+	})
+
+	It("holds back remediation when the peer reports the node as healthy", func() {
+		confirmer, server := newConfirmer(http.StatusConflict, nil)
+		defer server.Close()
+
+		Expect(confirmer.Confirm("unhealthy-node-1")).To(BeFalse())
+	})
+
+	It("confirms the node on an unexpected response", func() {
+		confirmer, server := newConfirmer(http.StatusInternalServerError, nil)
+		defer server.Close()
+
+		Expect(confirmer.Confirm("unhealthy-node-1")).To(BeTrue())
+	})
+
+	It("confirms the node when the peer is unreachable", func() {
+		confirmer, server := newConfirmer(http.StatusOK, nil)
+		server.Close()
+
+		Expect(confirmer.Confirm("unhealthy-node-1")).To(BeTrue())
+	})
+
+	It("confirms the node when the endpoint cannot be parsed", func() {
+		confirmer := NewHTTPConfirmer("http://\x00invalid", 1*time.Second, log)
+		Expect(confirmer.Confirm("unhealthy-node-1")).To(BeTrue())
+	})
+})
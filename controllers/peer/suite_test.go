@@ -0,0 +1,16 @@
+package peer
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// The confirmer only talks HTTP, so the peers are stubbed with httptest
+// servers and no test environment is needed.
+
+func TestPeer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Peer Suite")
+}
@@ -0,0 +1,50 @@
+// Package resources holds pure decision helpers extracted from the NHC
+// controller. Keeping them free of client and reconciler state lets their
+// decision matrices be unit-tested exhaustively, without a test environment.
+package resources
+
+// Reason labels returned by ShouldRemediate, explaining its decision. The
+// controller maps them to its events and log messages.
+const (
+	// ReasonNoUnhealthyNodes - there is nothing to remediate.
+	ReasonNoUnhealthyNodes = "no unhealthy nodes"
+	// ReasonPaused - remediation is paused by pause requests.
+	ReasonPaused = "pause requests in place"
+	// ReasonClusterUpgrading - remediation is deferred while the cluster upgrades.
+	ReasonClusterUpgrading = "cluster is upgrading"
+	// ReasonMinHealthyViolated - remediating would drop the healthy nodes below
+	// the minimum.
+	ReasonMinHealthyViolated = "too few healthy nodes"
+	// ReasonAllUnhealthyOverride - the MinHealthy gate is violated but every
+	// selected node is unhealthy and the NHC opted into remediating regardless.
+	ReasonAllUnhealthyOverride = "all nodes unhealthy, remediating regardless"
+)
+
+// ShouldRemediate is the pure remediation gating decision: given the observed
+// node counts and the NHC's gating inputs it reports whether remediation may
+// proceed, along with the reason label explaining the decision. An empty
+// reason means a plain pass of the MinHealthy gate; remediation proceeding
+// with a violated gate carries the override or, via the nonDisruptive input,
+// no reason because the gate doesn't apply.
+func ShouldRemediate(nodes, unhealthyNodes, minHealthy int, allowWhenAllUnhealthy, nonDisruptive, paused, upgrading bool) (bool, string) {
+	if unhealthyNodes == 0 {
+		return false, ReasonNoUnhealthyNodes
+	}
+	healthyNodes := nodes - unhealthyNodes
+	// with every selected node unhealthy the MinHealthy gate would block forever,
+	// guaranteeing a continued outage; users can opt into remediating regardless
+	allUnhealthyOverride := allowWhenAllUnhealthy && healthyNodes == 0 && nodes > 0 && minHealthy > 0
+	if healthyNodes < minHealthy && !allUnhealthyOverride && !nonDisruptive {
+		return false, ReasonMinHealthyViolated
+	}
+	if paused {
+		return false, ReasonPaused
+	}
+	if upgrading {
+		return false, ReasonClusterUpgrading
+	}
+	if allUnhealthyOverride {
+		return true, ReasonAllUnhealthyOverride
+	}
+	return true, ""
+}
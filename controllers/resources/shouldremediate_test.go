@@ -0,0 +1,96 @@
+package resources
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ShouldRemediate", func() {
+
+	It("should not remediate without unhealthy nodes", func() {
+		remediate, reason := ShouldRemediate(3, 0, 2, false, false, false, false)
+		Expect(remediate).To(BeFalse())
+		Expect(reason).To(Equal(ReasonNoUnhealthyNodes))
+	})
+
+	Context("the MinHealthy gate", func() {
+
+		It("should remediate while enough nodes stay healthy", func() {
+			remediate, reason := ShouldRemediate(3, 1, 2, false, false, false, false)
+			Expect(remediate).To(BeTrue())
+			Expect(reason).To(BeEmpty())
+		})
+
+		It("should not remediate below the minimum", func() {
+			remediate, reason := ShouldRemediate(3, 2, 2, false, false, false, false)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonMinHealthyViolated))
+		})
+
+		It("should remediate below the minimum when the remediation is non-disruptive", func() {
+			remediate, reason := ShouldRemediate(3, 2, 2, false, true, false, false)
+			Expect(remediate).To(BeTrue())
+			Expect(reason).To(BeEmpty())
+		})
+	})
+
+	Context("the all-unhealthy override", func() {
+
+		It("should remediate when all nodes are unhealthy and the NHC opted in", func() {
+			remediate, reason := ShouldRemediate(3, 3, 2, true, false, false, false)
+			Expect(remediate).To(BeTrue())
+			Expect(reason).To(Equal(ReasonAllUnhealthyOverride))
+		})
+
+		It("should not remediate when all nodes are unhealthy without the opt-in", func() {
+			remediate, reason := ShouldRemediate(3, 3, 2, false, false, false, false)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonMinHealthyViolated))
+		})
+
+		It("should not apply the override while some nodes are still healthy", func() {
+			remediate, reason := ShouldRemediate(3, 2, 2, true, false, false, false)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonMinHealthyViolated))
+		})
+
+		It("should not apply the override without selected nodes", func() {
+			remediate, reason := ShouldRemediate(0, 0, 2, true, false, false, false)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonNoUnhealthyNodes))
+		})
+	})
+
+	Context("pause and upgrade", func() {
+
+		It("should not remediate while paused", func() {
+			remediate, reason := ShouldRemediate(3, 1, 2, false, false, true, false)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonPaused))
+		})
+
+		It("should not remediate while the cluster is upgrading", func() {
+			remediate, reason := ShouldRemediate(3, 1, 2, false, false, false, true)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonClusterUpgrading))
+		})
+
+		It("should report the pause before the upgrade", func() {
+			remediate, reason := ShouldRemediate(3, 1, 2, false, false, true, true)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonPaused))
+		})
+
+		It("should report the violated gate before the pause", func() {
+			remediate, reason := ShouldRemediate(3, 2, 2, false, false, true, false)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonMinHealthyViolated))
+		})
+
+		It("should pause the all-unhealthy override as well", func() {
+			remediate, reason := ShouldRemediate(3, 3, 2, true, false, true, false)
+			Expect(remediate).To(BeFalse())
+			Expect(reason).To(Equal(ReasonPaused))
+		})
+	})
+})
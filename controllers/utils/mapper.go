@@ -37,7 +37,7 @@ func NHCByNodeMapperFunc(c client.Client, logger logr.Logger) handler.MapFunc {
 
 			node := &v1.Node{}
 			err = c.Get(context.Background(), client.ObjectKey{Name: o.GetName()}, node)
-			if selector.Matches(labels.Set(node.GetLabels())) {
+			if selector.Matches(labels.Set(node.GetLabels())) && primaryConditionRelevant(&nhc, node) {
 				r = append(r, reconcile.Request{NamespacedName: types.NamespacedName{Name: nhc.GetName()}})
 			}
 		}
@@ -45,3 +45,110 @@ func NHCByNodeMapperFunc(c client.Client, logger logr.Logger) handler.MapFunc {
 	}
 	return delegate
 }
+
+// NHCByInheritanceMapperFunc returns the parent-NHC-to-child-NHCs mapper function,
+// so changes of a parent NHC propagate to the NHCs inheriting from it.
+func NHCByInheritanceMapperFunc(c client.Client) handler.MapFunc {
+	delegate := func(o client.Object) []reconcile.Request {
+		var nhcList remediationv1alpha1.NodeHealthCheckList
+		if err := c.List(context.Background(), &nhcList, &client.ListOptions{}); err != nil {
+			return nil
+		}
+		var r []reconcile.Request
+		for _, nhc := range nhcList.Items {
+			if nhc.Spec.InheritFrom != nil && nhc.Spec.InheritFrom.Name == o.GetName() {
+				r = append(r, reconcile.Request{NamespacedName: types.NamespacedName{Name: nhc.GetName()}})
+			}
+		}
+		return r
+	}
+	return delegate
+}
+
+// NHCByTemplateMapperFunc returns the remediation-template-to-NHC mapper
+// function, so an NHC disabled because of a broken template re-reconciles
+// promptly once the template is fixed, instead of waiting for the next resync.
+func NHCByTemplateMapperFunc(c client.Client) handler.MapFunc {
+	delegate := func(o client.Object) []reconcile.Request {
+		var nhcList remediationv1alpha1.NodeHealthCheckList
+		if err := c.List(context.Background(), &nhcList, &client.ListOptions{}); err != nil {
+			return nil
+		}
+		var r []reconcile.Request
+		for i := range nhcList.Items {
+			if nhcReferencesTemplate(&nhcList.Items[i], o) {
+				r = append(r, reconcile.Request{NamespacedName: types.NamespacedName{Name: nhcList.Items[i].GetName()}})
+			}
+		}
+		return r
+	}
+	return delegate
+}
+
+// nhcReferencesTemplate reports whether one of the NHC's remediation template
+// references points at the given template object.
+func nhcReferencesTemplate(nhc *remediationv1alpha1.NodeHealthCheck, o client.Object) bool {
+	var refs []*v1.ObjectReference
+	if nhc.Spec.RemediationTemplate != nil {
+		refs = append(refs, nhc.Spec.RemediationTemplate)
+	}
+	for i := range nhc.Spec.EscalatingRemediations {
+		refs = append(refs, &nhc.Spec.EscalatingRemediations[i].RemediationTemplate)
+	}
+	for i := range nhc.Spec.RemediationByNodeInfo {
+		refs = append(refs, &nhc.Spec.RemediationByNodeInfo[i].RemediationTemplate)
+	}
+	gvk := o.GetObjectKind().GroupVersionKind()
+	for _, ref := range refs {
+		if ref.GroupVersionKind() == gvk && ref.Name == o.GetName() && ref.Namespace == o.GetNamespace() {
+			return true
+		}
+	}
+	return false
+}
+
+// primaryConditionRelevant reports whether the node is relevant for an NHC
+// which marks one of its unhealthy conditions as primary: either the primary
+// condition currently matches its unhealthy status, or the node has an
+// in-flight remediation whose healing must be observed promptly. The filter
+// only applies while the primary condition is the sole configured unhealthy
+// signal; as soon as other conditions, profiles, annotations, taints or
+// further health checks can make a node unhealthy, every matching node stays
+// relevant, otherwise those signals would never trigger a reconcile.
+func primaryConditionRelevant(nhc *remediationv1alpha1.NodeHealthCheck, node *v1.Node) bool {
+	var primary *remediationv1alpha1.UnhealthyCondition
+	for i := range nhc.Spec.UnhealthyConditions {
+		if nhc.Spec.UnhealthyConditions[i].Primary {
+			primary = &nhc.Spec.UnhealthyConditions[i]
+			break
+		}
+	}
+	if primary == nil || !primaryIsSoleUnhealthySignal(nhc) {
+		return true
+	}
+	if _, inFlight := nhc.Status.InFlightRemediations[node.GetName()]; inFlight {
+		return true
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == primary.Type && condition.Status == primary.Status {
+			return true
+		}
+	}
+	return false
+}
+
+// primaryIsSoleUnhealthySignal reports whether the primary unhealthy condition
+// is the only signal which can make a node unhealthy for this NHC.
+func primaryIsSoleUnhealthySignal(nhc *remediationv1alpha1.NodeHealthCheck) bool {
+	return len(nhc.Spec.UnhealthyConditions) == 1 &&
+		nhc.Spec.MinUnhealthyConditionCount <= 1 &&
+		len(nhc.Spec.ConditionProfiles) == 0 &&
+		len(nhc.Spec.UnhealthyAnnotations) == 0 &&
+		len(nhc.Spec.UnhealthyTaints) == 0 &&
+		len(nhc.Spec.ConditionFlapThresholds) == 0 &&
+		len(nhc.Spec.ResourceAvailabilityConditions) == 0 &&
+		nhc.Spec.HTTPProbe == nil &&
+		nhc.Spec.PodDisruptionThreshold == nil &&
+		nhc.Spec.MinKubeletVersion == "" &&
+		!nhc.Spec.RemediateOnKubeletCertExpiry
+}
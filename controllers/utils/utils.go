@@ -9,6 +9,34 @@ import (
 	"k8s.io/client-go/rest"
 )
 
+const (
+	// ControlPlaneRoleLabel marks a node as part of the control plane.
+	ControlPlaneRoleLabel = "node-role.kubernetes.io/control-plane"
+	// MasterRoleLabel is the legacy control plane role label, still set by
+	// older clusters.
+	MasterRoleLabel = "node-role.kubernetes.io/master"
+	// WorkerRoleLabel marks a node as a worker.
+	WorkerRoleLabel = "node-role.kubernetes.io/worker"
+)
+
+// IsControlPlane returns true if the node labels mark it as part of the
+// control plane, considering the legacy master label as well.
+func IsControlPlane(labels map[string]string) bool {
+	_, controlPlane := labels[ControlPlaneRoleLabel]
+	_, master := labels[MasterRoleLabel]
+	return controlPlane || master
+}
+
+// GetOperatorNodeName returns the name of the node the operator pod runs on,
+// as exposed via the downward API, or an empty string when not set.
+func GetOperatorNodeName() string {
+	// nodeNameEnvVar is the constant for env variable NODE_NAME which carries
+	// the name of the node hosting the operator pod, set via the downward API.
+	var nodeNameEnvVar = "NODE_NAME"
+
+	return os.Getenv(nodeNameEnvVar)
+}
+
 // GetDeploymentNamespace returns the Namespace this operator is deployed on.
 func GetDeploymentNamespace() (string, error) {
 	// deployNamespaceEnvVar is the constant for env variable DEPLOYMENT_NAMESPACE
@@ -36,6 +36,7 @@ import (
 
 	pkgruntime "k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -69,8 +70,82 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var enableDiagnostics bool
+	var recorderComponentSuffix string
+	var allowAllNodeSelectors bool
+	var removeStuckFinalizers bool
+	var pollShortConditionDurations bool
+	var defaultUnhealthyConditions string
+	var maxPauseDuration time.Duration
+	var maxRequeueInterval time.Duration
+	var clockSkewTolerance time.Duration
+	var mhcDisabledRequeueInterval time.Duration
+	var minReconcileInterval time.Duration
+	var resyncJitter time.Duration
+	var successRateWindow time.Duration
+	var watchNamespace string
+	var upgradeStateConfigMap string
+	var nodeListPageSize int64
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
+	flag.StringVar(&watchNamespace, "watch-namespace", "",
+		"Namespace the manager cache and the remediation CR operations are scoped to, for installs which are "+
+			"only permitted to manage remediation CRs in one namespace. Nodes and NodeHealthChecks are "+
+			"cluster-scoped and stay watched cluster-wide. Empty means cluster scope.")
+	flag.DurationVar(&maxRequeueInterval, "max-requeue-interval", controllers.MaxRequeueInterval,
+		"Upper bound for the requeue intervals the controllers compute, so every NHC is re-checked "+
+			"within a bounded interval. Zero disables the cap.")
+	flag.DurationVar(&mhcDisabledRequeueInterval, "mhc-disabled-requeue-interval", 5*time.Minute,
+		"How often an NHC which is disabled because of conflicting MachineHealthChecks re-evaluates the conflict, "+
+			"so it re-enables promptly once the MHCs are gone. Zero disables the periodic re-check.")
+	flag.DurationVar(&minReconcileInterval, "min-reconcile-interval", 0,
+		"Minimum time between reconciles of the same NodeHealthCheck, coalescing rapid node events "+
+			"into one reconcile of the latest state to reduce API churn under node flapping. "+
+			"Deferred reconciles are requeued, not dropped. Zero means the built-in default.")
+	flag.DurationVar(&resyncJitter, "resync-jitter", 0,
+		"Maximum random offset added to the periodic re-check intervals the reconciles compute, "+
+			"spreading the re-checks of many NodeHealthChecks over time instead of causing synchronized "+
+			"API load spikes. Event-driven reconciles are not delayed. Zero disables the jitter.")
+	flag.DurationVar(&successRateWindow, "remediation-success-rate-window", controllers.RemediationSuccessRateWindow,
+		"Rolling window over which the remediation success rate status field and metric are computed. "+
+			"Remediations which completed longer ago no longer count.")
+	flag.DurationVar(&clockSkewTolerance, "clock-skew-tolerance", 0,
+		"Added to the unhealthy condition durations when comparing against node-reported transition times, "+
+			"so slightly skewed node clocks don't cause premature remediation. "+
+			"The tradeoff is added remediation latency of up to the tolerance.")
+	flag.DurationVar(&maxPauseDuration, "max-pause-duration", 0,
+		"Guardrail after which remediation resumes even when pause requests are still in place, "+
+			"e.g. because someone forgot to remove them. Zero disables the guardrail.")
+	flag.StringVar(&defaultUnhealthyConditions, "default-unhealthy-conditions", "",
+		"Comma separated list of \"Type:Status:Duration\" entries, e.g. \"Ready:False:600s,Ready:Unknown:600s\", "+
+			"applied as operator-level default unhealthyConditions to NHCs which don't set their own, "+
+			"overriding the CRD-level defaults.")
+	flag.BoolVar(&pollShortConditionDurations, "poll-short-condition-durations", false,
+		"Re-check NodeHealthChecks whose shortest unhealthy condition duration is below the cache "+
+			"resync period on the duration's cadence, so e.g. a 30s condition isn't only re-checked "+
+			"every 60s. The tradeoff is more frequent reconciles of such NodeHealthChecks.")
+	flag.BoolVar(&removeStuckFinalizers, "remove-stuck-remediation-finalizers", false,
+		"Force-remove the finalizers of remediation CRs whose deletion is stuck, so a wedged remediator "+
+			"doesn't keep its nodes counted as in-flight remediations forever. Without the flag stuck "+
+			"deletions are only reported via event and metric.")
+	flag.BoolVar(&allowAllNodeSelectors, "allow-all-node-selectors", false,
+		"Allow NHCs with an empty selector, which match all nodes in the cluster, "+
+			"without requiring the confirmation annotation.")
+	flag.StringVar(&upgradeStateConfigMap, "upgrade-state-configmap", "",
+		"Name of a ConfigMap in the operator's namespace to read the cluster upgrade state from, "+
+			"instead of the platform's own upgrade state representation. Remediation is skipped while "+
+			"its \"upgrading\" key is \"true\". Meant for platforms other than OpenShift, where "+
+			"upgrades aren't detected otherwise. Empty means platform detection.")
+	flag.Int64Var(&nodeListPageSize, "node-list-page-size", 0,
+		"List the selected nodes in pages of this size directly from the API server, keeping only the "+
+			"fields the health evaluation needs, to reduce the per-reconcile memory footprint in very "+
+			"large clusters. Health decisions still consider all matching nodes. Zero keeps the single "+
+			"cached list.")
+	flag.StringVar(&recorderComponentSuffix, "event-recorder-component-suffix", "",
+		"Suffix appended to the component name the event recorders use, e.g. \"-staging\". "+
+			"Useful for attributing events to a specific operator deployment when multiple instances run in one cluster.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.BoolVar(&enableDiagnostics, "enable-diagnostics-endpoint", false,
+		"Enable the diagnostics endpoint on the metrics address, which dumps config and status of all NHCs as JSON.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", true,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
@@ -94,13 +169,19 @@ func main() {
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "e1f13584.medik8s.io",
 		SyncPeriod:             &syncPeriod,
+		Namespace:              watchNamespace,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
-	upgradeChecker, err := cluster.NewClusterUpgradeStatusChecker(mgr)
+	var upgradeChecker cluster.UpgradeChecker
+	if upgradeStateConfigMap != "" {
+		upgradeChecker, err = cluster.NewConfigMapClusterUpgradeStatusChecker(mgr, upgradeStateConfigMap)
+	} else {
+		upgradeChecker, err = cluster.NewClusterUpgradeStatusChecker(mgr)
+	}
 	if err != nil {
 		setupLog.Error(err, "unable initialize cluster upgrade checker")
 		os.Exit(1)
@@ -116,23 +197,50 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err := (&controllers.NodeHealthCheckReconciler{
-		Client:                      mgr.GetClient(),
-		Log:                         ctrl.Log.WithName("controllers").WithName("NodeHealthCheck"),
-		Scheme:                      mgr.GetScheme(),
-		Recorder:                    mgr.GetEventRecorderFor("NodeHealthCheck"),
-		ClusterUpgradeStatusChecker: upgradeChecker,
-		MHCChecker:                  mhcChecker,
-	}).SetupWithManager(mgr); err != nil {
+	// a plain clientset for the pod eviction subresource, which the
+	// controller-runtime client doesn't expose
+	clientSet, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		setupLog.Error(err, "unable to create a clientset")
+		os.Exit(1)
+	}
+
+	nhcReconciler := &controllers.NodeHealthCheckReconciler{
+		Client:                           mgr.GetClient(),
+		Log:                              ctrl.Log.WithName("controllers").WithName("NodeHealthCheck"),
+		Scheme:                           mgr.GetScheme(),
+		Recorder:                         mgr.GetEventRecorderFor("NodeHealthCheck" + recorderComponentSuffix),
+		ClusterUpgradeStatusChecker:      upgradeChecker,
+		MHCChecker:                       mhcChecker,
+		MaxPauseDuration:                 maxPauseDuration,
+		MHCDisabledRequeueInterval:       mhcDisabledRequeueInterval,
+		WatchNamespace:                   watchNamespace,
+		MinReconcileInterval:             minReconcileInterval,
+		ResyncJitter:                     resyncJitter,
+		PollShortConditionDurations:      pollShortConditionDurations,
+		RemoveStuckRemediationFinalizers: removeStuckFinalizers,
+		NodeListPageSize:                 nodeListPageSize,
+		APIReader:                        mgr.GetAPIReader(),
+		ClientSet:                        clientSet,
+	}
+	if err := nhcReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "NodeHealthCheck")
 		os.Exit(1)
 	}
 
+	if enableDiagnostics {
+		if err := mgr.AddMetricsExtraHandler(controllers.DiagnosticsPath,
+			controllers.NewDiagnosticsHandler(nhcReconciler, ctrl.Log.WithName("diagnostics"))); err != nil {
+			setupLog.Error(err, "unable to set up the diagnostics endpoint")
+			os.Exit(1)
+		}
+	}
+
 	if err := (&controllers.MachineHealthCheckReconciler{
 		Client:                      mgr.GetClient(),
 		Log:                         ctrl.Log.WithName("controllers").WithName("MachineHealthCheck"),
 		Scheme:                      mgr.GetScheme(),
-		Recorder:                    mgr.GetEventRecorderFor("MachineHealthCheck"),
+		Recorder:                    mgr.GetEventRecorderFor("MachineHealthCheck" + recorderComponentSuffix),
 		ClusterUpgradeStatusChecker: upgradeChecker,
 		MHCChecker:                  mhcChecker,
 	}).SetupWithManager(mgr); err != nil {
@@ -140,6 +248,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	controllers.MaxRequeueInterval = maxRequeueInterval
+	controllers.ClockSkewTolerance = clockSkewTolerance
+	controllers.RemediationSuccessRateWindow = successRateWindow
+	remediationv1alpha1.AllowAllNodeSelectors = allowAllNodeSelectors
+	if defaultUnhealthyConditions != "" {
+		conditions, err := remediationv1alpha1.ParseUnhealthyConditions(defaultUnhealthyConditions)
+		if err != nil {
+			setupLog.Error(err, "invalid default-unhealthy-conditions flag")
+			os.Exit(1)
+		}
+		remediationv1alpha1.DefaultUnhealthyConditions = conditions
+	}
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = (&remediationv1alpha1.NodeHealthCheck{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "NodeHealthCheck")
+			os.Exit(1)
+		}
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	// Do some initialization, it potentially exits!
@@ -16,6 +16,9 @@
 package metrics
 
 import (
+	"strconv"
+	"sync"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
@@ -29,14 +32,199 @@ var (
 			Help: "Number of old remediation CRs detected by NodeHealthChecks",
 		}, []string{"name", "namespace"},
 	)
+
+	// NodeHealthCheckOldestRemediationAge is a Prometheus metric, which reports the age of the oldest in-flight remediation CR per NodeHealthCheck.
+	// It allows alerting on remediations that aren't completing, at a user-defined threshold.
+	NodeHealthCheckOldestRemediationAge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "nodehealthcheck_oldest_remediation_age_seconds",
+			Help: "Age in seconds of the oldest in-flight remediation CR per NodeHealthCheck",
+		}, []string{"name"},
+	)
+
+	// NodeHealthCheckSelectedNodes is a Prometheus metric, which reports the number of nodes each NodeHealthCheck's selector currently matches, split by node role.
+	// It is the denominator behind all ratio based decisions of the NHC and allows tracking node pool growth and shrinkage per NodeHealthCheck.
+	NodeHealthCheckSelectedNodes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "nodehealthcheck_selected_nodes",
+			Help: "Number of nodes selected by each NodeHealthCheck, split by node role",
+		}, []string{"name", "role"},
+	)
+
+	// NodeHealthCheckEscalationStepReached is a Prometheus metric, which counts how often each escalation step was reached, i.e. a remediation CR was created for it.
+	// Combined with the completion metrics it shows which steps actually heal nodes, for tuning escalation chains.
+	NodeHealthCheckEscalationStepReached = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "nodehealthcheck_escalation_step_reached_total",
+			Help: "Number of remediation CRs created per escalation step, as counted by NodeHealthChecks",
+		}, []string{"name", "order"},
+	)
+
+	// NodeHealthCheckStuckTerminatingRemediations is a Prometheus metric, which counts the remediation CRs whose deletion got stuck on finalizers.
+	// It indicates a wedged remediator, which keeps its nodes counted as in-flight remediations.
+	NodeHealthCheckStuckTerminatingRemediations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "nodehealthcheck_stuck_terminating_remediations_total",
+			Help: "Number of remediation CRs whose deletion got stuck on finalizers, as detected by NodeHealthChecks",
+		}, []string{"name", "remediator"},
+	)
+
+	// NodeHealthCheckRemediationSuccessRatio is a Prometheus metric, which reports the share of remediations which recovered their node, per NodeHealthCheck over a rolling window.
+	// It gives a single signal to alert on when a remediator stops healing nodes. The sample is absent, not 0, while no remediation completed within the window.
+	NodeHealthCheckRemediationSuccessRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "nodehealthcheck_remediation_success_ratio",
+			Help: "Share of remediations which recovered their node, per NodeHealthCheck over the configured rolling window",
+		}, []string{"name"},
+	)
+
+	// NodeHealthCheckRemediatorIncidents is a Prometheus metric, which counts the completed incidents per remediator kind and outcome.
+	// It allows evaluating remediator reliability over time, e.g. for capacity or vendor analysis.
+	NodeHealthCheckRemediatorIncidents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "nodehealthcheck_remediator_incidents_total",
+			Help: "Number of completed incidents per remediator kind and outcome, as observed by NodeHealthChecks",
+		}, []string{"name", "remediator", "outcome"},
+	)
+)
+
+var (
+	// remediationBucketsByKind tunes the latency histogram buckets per remediator
+	// kind: a reboot based remediator completes within minutes, while
+	// reprovisioning a machine takes much longer, so shared buckets would make
+	// the percentiles of one of them useless. Unknown kinds fall back to the
+	// default buckets.
+	remediationBucketsByKind = map[string][]float64{
+		"SelfNodeRemediation":        {15, 30, 60, 120, 240, 480, 900},
+		"FenceAgentsRemediation":     {15, 30, 60, 120, 240, 480, 900},
+		"MachineDeletionRemediation": {300, 600, 1200, 1800, 3600, 7200, 14400},
+	}
+	defaultRemediationBuckets = []float64{30, 60, 120, 300, 600, 1200, 1800, 3600}
+
+	// the histograms are created lazily per remediator kind, since buckets are
+	// fixed per collector and the kinds are only known at runtime
+	remediationCreationDelay  = map[string]*prometheus.HistogramVec{}
+	remediationToHealthy      = map[string]*prometheus.HistogramVec{}
+	remediationHistogramsLock sync.Mutex
 )
 
+func remediationBuckets(remediator string) []float64 {
+	if buckets, known := remediationBucketsByKind[remediator]; known {
+		return buckets
+	}
+	return defaultRemediationBuckets
+}
+
+func remediationHistogram(histograms map[string]*prometheus.HistogramVec, name string, help string, remediator string) *prometheus.HistogramVec {
+	remediationHistogramsLock.Lock()
+	defer remediationHistogramsLock.Unlock()
+	histogram, exists := histograms[remediator]
+	if !exists {
+		histogram = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:        name,
+				Help:        help,
+				ConstLabels: prometheus.Labels{"remediator": remediator},
+				Buckets:     remediationBuckets(remediator),
+			}, []string{"name"},
+		)
+		metrics.Registry.MustRegister(histogram)
+		histograms[remediator] = histogram
+	}
+	return histogram
+}
+
+// ObserveNodeHealthCheckRemediationCreationDelay reports the delay between a node
+// turning unhealthy and the creation of its remediation CR, per remediator kind.
+func ObserveNodeHealthCheckRemediationCreationDelay(name string, remediator string, seconds float64) {
+	remediationHistogram(remediationCreationDelay, "nodehealthcheck_remediation_creation_delay_seconds",
+		"Delay in seconds between a node turning unhealthy and the creation of its remediation CR", remediator).
+		With(prometheus.Labels{"name": name}).Observe(seconds)
+}
+
+// ObserveNodeHealthCheckRemediationToHealthy reports the duration between the
+// creation of a remediation CR and the node turning healthy again, per
+// remediator kind.
+func ObserveNodeHealthCheckRemediationToHealthy(name string, remediator string, seconds float64) {
+	remediationHistogram(remediationToHealthy, "nodehealthcheck_remediation_to_healthy_seconds",
+		"Duration in seconds between the creation of a remediation CR and the node turning healthy again", remediator).
+		With(prometheus.Labels{"name": name}).Observe(seconds)
+}
+
 func InitializeNodeHealthCheckMetrics() {
 	metrics.Registry.MustRegister(
 		NodeHealthCheckOldRemediationCR,
+		NodeHealthCheckOldestRemediationAge,
+		NodeHealthCheckSelectedNodes,
+		NodeHealthCheckEscalationStepReached,
+		NodeHealthCheckStuckTerminatingRemediations,
+		NodeHealthCheckRemediationSuccessRatio,
+		NodeHealthCheckRemediatorIncidents,
 	)
 }
 
+func SetNodeHealthCheckSelectedNodes(name string, controlPlaneNodes int, workerNodes int) {
+	NodeHealthCheckSelectedNodes.With(prometheus.Labels{
+		"name": name,
+		"role": "control-plane",
+	}).Set(float64(controlPlaneNodes))
+	NodeHealthCheckSelectedNodes.With(prometheus.Labels{
+		"name": name,
+		"role": "worker",
+	}).Set(float64(workerNodes))
+}
+
+func DeleteNodeHealthCheckSelectedNodes(name string) {
+	NodeHealthCheckSelectedNodes.Delete(prometheus.Labels{
+		"name": name,
+		"role": "control-plane",
+	})
+	NodeHealthCheckSelectedNodes.Delete(prometheus.Labels{
+		"name": name,
+		"role": "worker",
+	})
+}
+
+func ObserveNodeHealthCheckEscalationStepReached(name string, order int) {
+	NodeHealthCheckEscalationStepReached.With(prometheus.Labels{
+		"name":  name,
+		"order": strconv.Itoa(order),
+	}).Inc()
+}
+
+func ObserveNodeHealthCheckStuckTerminatingRemediation(name string, remediator string) {
+	NodeHealthCheckStuckTerminatingRemediations.With(prometheus.Labels{
+		"name":       name,
+		"remediator": remediator,
+	}).Inc()
+}
+
+func ObserveNodeHealthCheckRemediatorIncident(name string, remediator string, outcome string) {
+	NodeHealthCheckRemediatorIncidents.With(prometheus.Labels{
+		"name":       name,
+		"remediator": remediator,
+		"outcome":    outcome,
+	}).Inc()
+}
+
+func SetNodeHealthCheckOldestRemediationAge(name string, ageSeconds float64) {
+	NodeHealthCheckOldestRemediationAge.With(prometheus.Labels{
+		"name": name,
+	}).Set(ageSeconds)
+}
+
+func SetNodeHealthCheckRemediationSuccessRatio(name string, ratio float64) {
+	NodeHealthCheckRemediationSuccessRatio.With(prometheus.Labels{
+		"name": name,
+	}).Set(ratio)
+}
+
+func DeleteNodeHealthCheckRemediationSuccessRatio(name string) {
+	NodeHealthCheckRemediationSuccessRatio.Delete(prometheus.Labels{
+		"name": name,
+	})
+}
+
 func ObserveNodeHealthCheckOldRemediationCR(name string, namespace string) {
 	NodeHealthCheckOldRemediationCR.With(prometheus.Labels{
 		"name":      name,